@@ -0,0 +1,87 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"golang.org/x/mod/semver"
+)
+
+// VersionWallixAPIAuto selects the highest API version supported by both
+// the provider and the bastion.
+const VersionWallixAPIAuto = "auto"
+
+// capability is a feature of the bastion API which isn't available with
+// every supported API version.
+type capability string
+
+const (
+	capabilityApplicationCategory  capability = "application_category"
+	capabilityConnectionPolicyType capability = "connection_policy_type"
+)
+
+// capabilityMinVersion is the first API version (and the bastion version
+// which ships it) supporting a capability.
+type capabilityMinVersion struct {
+	apiVersion     string
+	bastionVersion string
+}
+
+func capabilitiesMinVersion() map[capability]capabilityMinVersion {
+	return map[capability]capabilityMinVersion{
+		capabilityApplicationCategory:  {apiVersion: VersionWallixAPI312, bastionVersion: "12.0"},
+		capabilityConnectionPolicyType: {apiVersion: VersionWallixAPI312, bastionVersion: "12.0"},
+	}
+}
+
+// apiCapabilities returns the set of capabilities available with apiVersion.
+func apiCapabilities(apiVersion string) map[capability]bool {
+	result := make(map[capability]bool)
+	for k, v := range capabilitiesMinVersion() {
+		if semver.Compare(apiVersion, v.apiVersion) >= 0 {
+			result[k] = true
+		}
+	}
+
+	return result
+}
+
+func (c *Client) hasCapability(capa capability) bool {
+	return c.capabilities[capa]
+}
+
+// checkCapability returns an error naming the minimum bastion version
+// when capa isn't available, with what as the unsupported usage.
+func (c *Client) checkCapability(capa capability, what string) error {
+	if c.hasCapability(capa) {
+		return nil
+	}
+	minVersion := capabilitiesMinVersion()[capa]
+
+	return fmt.Errorf("%s requires bastion %s or later (api version %s), current api version is %s",
+		what, minVersion.bastionVersion, minVersion.apiVersion, c.bastionAPIVersion)
+}
+
+// detectAPIVersion returns the highest API version supported by both the
+// provider and the bastion, from the version endpoint of the bastion.
+func (c *Client) detectAPIVersion(ctx context.Context) (string, error) {
+	version, err := readVersionOptions(ctx, c)
+	if err != nil {
+		return "", fmt.Errorf("detecting api version: %w", err)
+	}
+	bastionVersion := "v" + version.Version
+	if !semver.IsValid(bastionVersion) {
+		return "", fmt.Errorf("detecting api version: unexpected version %q returned by bastion", version.Version)
+	}
+	versions := defaultVersionsValid()
+	slices.SortFunc(versions, semver.Compare)
+	for _, v := range slices.Backward(versions) {
+		if semver.Compare(v, bastionVersion) <= 0 {
+			return v, nil
+		}
+	}
+
+	return "", fmt.Errorf("detecting api version: bastion api version %s is older than the supported ones %v",
+		version.Version, defaultVersionsValid())
+}
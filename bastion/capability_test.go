@@ -0,0 +1,130 @@
+package bastion
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDetectAPIVersion(t *testing.T) {
+	tests := []struct {
+		bastionVersion string
+		want           string
+		wantErr        bool
+	}{
+		{bastionVersion: "3.12", want: VersionWallixAPI312},
+		{bastionVersion: "3.14", want: VersionWallixAPI312},
+		{bastionVersion: "3.10", want: VersionWallixAPI38},
+		{bastionVersion: "3.8", want: VersionWallixAPI38},
+		{bastionVersion: "3.6", wantErr: true},
+		{bastionVersion: "unknown", wantErr: true},
+	}
+	for _, tt := range tests {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/version" {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+			_, _ = w.Write([]byte(`{"version": "` + tt.bastionVersion + `"}`))
+		}))
+		client := newTestConfigClient(t, server, Config{
+			insecureSkipVerify: true,
+		})
+		client.bastionAPIVersion = VersionWallixAPIAuto
+		got, err := client.detectAPIVersion(context.Background())
+		server.Close()
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("detectAPIVersion with bastion %s: expected an error", tt.bastionVersion)
+			}
+
+			continue
+		}
+		if err != nil {
+			t.Errorf("detectAPIVersion with bastion %s: unexpected error: %s", tt.bastionVersion, err)
+		}
+		if got != tt.want {
+			t.Errorf("detectAPIVersion with bastion %s = %s, want %s", tt.bastionVersion, got, tt.want)
+		}
+	}
+}
+
+func TestConfigClientAPIVersionAuto(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"version": "3.12"}`))
+	}))
+	defer server.Close()
+	testClient := newTestClient(t, server)
+	config := Config{
+		bastionScheme:      testClient.bastionScheme,
+		bastionHosts:       testClient.bastionNodes,
+		bastionAPIVersion:  VersionWallixAPIAuto,
+		insecureSkipVerify: true,
+	}
+	client, diags := config.Client(context.Background())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if client.bastionAPIVersion != VersionWallixAPI312 {
+		t.Errorf("got api version %s, want %s", client.bastionAPIVersion, VersionWallixAPI312)
+	}
+	if !client.hasCapability(capabilityConnectionPolicyType) {
+		t.Errorf("capability %s missing with api version %s", capabilityConnectionPolicyType, client.bastionAPIVersion)
+	}
+}
+
+func TestConfigClientAPIVersionAutoPasswordAuth(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if r.URL.Path != "/api/version" || !ok || user != "admin" || password != "aPassword" ||
+			r.Header.Get("X-Auth-Key") != "" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+		_, _ = w.Write([]byte(`{"version": "3.12"}`))
+	}))
+	defer server.Close()
+	downServer := httptest.NewTLSServer(http.NotFoundHandler())
+	downServer.Close()
+	testClient := newTestClient(t, server)
+	downClient := newTestClient(t, downServer)
+	config := Config{
+		bastionScheme:      testClient.bastionScheme,
+		bastionHosts:       append(downClient.bastionNodes, testClient.bastionNodes...),
+		bastionAPIVersion:  VersionWallixAPIAuto,
+		bastionUser:        "admin",
+		bastionPwd:         "aPassword",
+		insecureSkipVerify: true,
+	}
+	client, diags := config.Client(context.Background())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if client.bastionAPIVersion != VersionWallixAPI312 {
+		t.Errorf("got api version %s, want %s", client.bastionAPIVersion, VersionWallixAPI312)
+	}
+}
+
+func TestCheckCapability(t *testing.T) {
+	client := &Client{
+		bastionAPIVersion: VersionWallixAPI38,
+		capabilities:      apiCapabilities(VersionWallixAPI38),
+	}
+	err := client.checkCapability(capabilityApplicationCategory, "category = jumphost")
+	if err == nil {
+		t.Fatal("expected an error with api version v3.8")
+	}
+	if !strings.Contains(err.Error(), "bastion 12.0") {
+		t.Errorf("error doesn't name the minimum bastion version: %s", err)
+	}
+
+	client.bastionAPIVersion = VersionWallixAPI312
+	client.capabilities = apiCapabilities(VersionWallixAPI312)
+	if err := client.checkCapability(capabilityApplicationCategory, "category = jumphost"); err != nil {
+		t.Errorf("unexpected error with api version v3.12: %s", err)
+	}
+}
@@ -6,68 +6,238 @@ import (
 	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
+	"time"
 
-	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
 )
 
 // Information to connect on Wallix bastion.
 type Client struct {
-	bastionPort       int
+	bastionScheme     string
+	bastionNodes      []string
+	nodeMutex         sync.Mutex
+	nodeIndex         int
 	bastionAPIVersion string
-	bastionIP         string
 	bastionToken      string
 	bastionUser       string
 	bastionPwd        string
+	maxRetries        int
+	retryMinWait      time.Duration
+	retryMaxWait      time.Duration
+	httpClient        *http.Client
+	session           *session
+	capabilities      map[capability]bool
 }
 
-var defaultHTTPClient *http.Client //nolint:gochecknoglobals
+func (c *Client) newRequest(ctx context.Context, uri string, method string, jsonBody interface{}) (string, int, error) {
+	path := "/api/" + c.bastionAPIVersion
+	if strings.HasPrefix(uri, "/") {
+		path += uri
+	} else {
+		path += "/" + uri
+	}
 
-func init() { //nolint:gochecknoinits
-	transport := cleanhttp.DefaultPooledTransport()
-	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint: gosec
-	defaultHTTPClient = &http.Client{Transport: transport}
+	return c.newPathRequest(ctx, path, method, jsonBody)
 }
 
-func (c *Client) newRequest(ctx context.Context, uri string, method string, jsonBody interface{}) (string, int, error) {
+// newPathRequest is newRequest with the full path of the request, without
+// the prefix of the API version, like the version endpoint of the bastion.
+func (c *Client) newPathRequest(
+	ctx context.Context, path string, method string, jsonBody interface{},
+) (
+	string, int, error,
+) {
 	body := new(bytes.Buffer)
 	err := json.NewEncoder(body).Encode(jsonBody)
 	if err != nil {
 		return "", http.StatusInternalServerError, fmt.Errorf("decoding json: %w", err)
 	}
-	url := "https://" + c.bastionIP + ":" + strconv.Itoa(c.bastionPort) + "/api/" + c.bastionAPIVersion
-	if strings.HasPrefix(uri, "/") {
-		url += uri
-	} else {
-		url += "/" + uri
+	ctx = withLogSubsystem(ctx)
+	for attempt := 0; ; attempt++ {
+		ctx := tflog.SubsystemSetField(ctx, logSubsystem, "attempt", attempt+1)
+		respBody, code, header, err := c.sendRequestFailover(ctx, path, method, body.Bytes())
+		if attempt >= c.maxRetries || !isRetryableRequest(method, code, err) {
+			if err != nil {
+				return "", http.StatusInternalServerError, timeoutError(ctx, method, path, err)
+			}
+
+			return respBody, code, nil
+		}
+		wait := c.retryWait(attempt, header)
+		logRetry(ctx, method, path, code, err, wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return "", http.StatusInternalServerError,
+				timeoutError(ctx, method, path, fmt.Errorf("waiting to retry http request: %w", ctx.Err()))
+		case <-timer.C:
+		}
+	}
+}
+
+// baseURL returns the scheme, host and port of the active node of the bastion API.
+func (c *Client) baseURL() string {
+	_, node := c.activeNode()
+
+	return c.nodeURL(node)
+}
+
+// nodeURL returns the scheme, host and port of a node of the bastion API.
+func (c *Client) nodeURL(node string) string {
+	return c.bastionScheme + "://" + node
+}
+
+func (c *Client) sendRequest(
+	ctx context.Context, url string, method string, body []byte,
+) (
+	string, int, http.Header, error,
+) {
+	respBody, code, header, sessionUsed, err := c.doRequest(ctx, url, method, body)
+	if err == nil && sessionUsed && code == http.StatusUnauthorized {
+		// the bastion session has expired, authenticate again with credentials
+		c.session.reset()
+		respBody, code, header, _, err = c.doRequest(ctx, url, method, body)
+	}
+
+	return respBody, code, header, err
+}
+
+func (c *Client) doRequest(
+	ctx context.Context, url string, method string, body []byte,
+) (
+	string, int, http.Header, bool, error,
+) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return "", 0, nil, false, fmt.Errorf("preparing http request: %w", err)
 	}
-	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	req.Header.Add("Content-Type", "application/json; charset=utf-8")
 	req.Header.Add("User-Agent", "terraform-provider-wallix-bastion")
-	if c.bastionToken != "" {
+	var sessionCookies []*http.Cookie
+	if c.session != nil {
+		sessionCookies = c.session.cookies(req.URL)
+	}
+	switch {
+	case len(sessionCookies) > 0:
+		for _, cookie := range sessionCookies {
+			req.AddCookie(cookie)
+		}
+	case c.bastionToken != "":
 		req.Header.Add("X-Auth-Key", c.bastionToken)
 		req.Header.Add("X-Auth-User", c.bastionUser)
-	} else {
+	default:
 		rawcreds := c.bastionUser + ":" + c.bastionPwd
 		encodedcreds := base64.StdEncoding.EncodeToString([]byte(rawcreds))
 		req.Header.Add("Authorization", "Basic "+encodedcreds)
 	}
+	logRequest(ctx, req, body)
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return "", http.StatusInternalServerError, fmt.Errorf("preparing http request: %w", err)
-	}
-	resp, err := defaultHTTPClient.Do(req)
-	if err != nil {
-		return "", http.StatusInternalServerError, fmt.Errorf("sending http request: %w", err)
+		logRequestError(ctx, req, err, time.Since(start))
+
+		return "", 0, nil, false, wrapSendError(req.URL.Host, err)
 	}
 	defer resp.Body.Close()
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", http.StatusInternalServerError, fmt.Errorf("reading http response: %w", err)
+		logRequestError(ctx, req, err, time.Since(start))
+
+		return "", 0, nil, false, fmt.Errorf("reading http response: %w", err)
+	}
+	logResponse(ctx, req, resp, respBody, time.Since(start))
+	if c.session != nil && resp.StatusCode != http.StatusUnauthorized {
+		c.session.setCookies(req.URL, resp.Cookies())
+	}
+
+	return string(respBody), resp.StatusCode, resp.Header, len(sessionCookies) > 0, nil
+}
+
+// wrapSendError adds a hint on how to trust the bastion certificate when the
+// TLS handshake fails on certificate verification.
+func wrapSendError(host string, err error) error {
+	var certErr *tls.CertificateVerificationError
+	if errors.As(err, &certErr) || errors.Is(err, errServerCertFingerprint) {
+		return fmt.Errorf("sending http request: verifying TLS certificate of %s: %w "+
+			"(set ca_cert_file, ca_cert_pem or server_cert_fingerprint to trust it, "+
+			"or insecure_skip_verify to disable verification)", host, err)
+	}
+
+	return fmt.Errorf("sending http request: %w", err)
+}
+
+// isRetryableRequest reports whether a failed attempt can be sent again.
+// A 429 means the bastion refused the request before processing it, so it is
+// retried whatever the method; other transient failures are only retried
+// for idempotent methods.
+func isRetryableRequest(method string, code int, err error) bool {
+	idempotent := slices.Contains([]string{
+		http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete,
+	}, method)
+	if err != nil {
+		return idempotent &&
+			(errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF))
+	}
+	switch code {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent
+	}
+
+	return false
+}
+
+// retryWait returns the delay before the next attempt: the Retry-After
+// header when the bastion sends one, otherwise an exponential backoff with
+// jitter, both capped by retryMaxWait.
+func (c *Client) retryWait(attempt int, header http.Header) time.Duration {
+	if wait, ok := parseRetryAfter(header.Get("Retry-After"), time.Now()); ok {
+		return min(wait, c.retryMaxWait)
+	}
+	backoff := c.retryMaxWait
+	if attempt < 32 {
+		backoff = min(c.retryMinWait<<attempt, c.retryMaxWait)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	return backoff/2 + rand.N(backoff/2+1) //nolint:gosec
+}
+
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
 	}
 
-	return string(respBody), resp.StatusCode, nil
+	return 0, false
 }
@@ -0,0 +1,275 @@
+package bastion
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, server *httptest.Server) *Client {
+	t.Helper()
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Client{
+		bastionScheme:     u.Scheme,
+		bastionNodes:      []string{u.Host},
+		bastionAPIVersion: VersionWallixAPI38,
+		bastionUser:       "admin",
+		bastionPwd:        "admin",
+		maxRetries:        defaultMaxRetries,
+		retryMinWait:      time.Millisecond,
+		retryMaxWait:      10 * time.Millisecond,
+		httpClient:        server.Client(),
+	}
+}
+
+func TestClientNewRequestRetry(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	body, code, err := newTestClient(t, server).newRequest(context.Background(), "/users/", http.MethodGet, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if code != http.StatusOK {
+		t.Errorf("got status %d, want %d", code, http.StatusOK)
+	}
+	if body != `{"ok":true}` {
+		t.Errorf("got body %q", body)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("got %d calls, want 3", got)
+	}
+}
+
+func TestClientNewRequestRetryExhausted(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	_, code, err := client.newRequest(context.Background(), "/devices/", http.MethodDelete, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if code != http.StatusBadGateway {
+		t.Errorf("got status %d, want %d", code, http.StatusBadGateway)
+	}
+	if got := calls.Load(); got != int32(client.maxRetries+1) {
+		t.Errorf("got %d calls, want %d", got, client.maxRetries+1)
+	}
+}
+
+func TestClientNewRequestNoRetryPost(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, code, err := newTestClient(t, server).newRequest(context.Background(), "/devices/", http.MethodPost, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want %d", code, http.StatusServiceUnavailable)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("got %d calls, want 1", got)
+	}
+}
+
+func TestClientNewRequestRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	client.maxRetries = 1
+	_, code, err := client.newRequest(context.Background(), "/devices/", http.MethodPost, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if code != http.StatusNoContent {
+		t.Errorf("got status %d, want %d", code, http.StatusNoContent)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("got %d calls, want 2", got)
+	}
+}
+
+func TestClientNewRequestRetryContextCanceled(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	client.retryMaxWait = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := client.newRequest(ctx, "/users/", http.MethodGet, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClientNewRequestTimeout(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctx, cancel := withTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := newTestClient(t, server).newRequest(ctx, "/domains/", http.MethodPost, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if want := "POST /api/" + VersionWallixAPI38 + "/domains/ timed out after 50ms"; err == nil ||
+		!strings.HasPrefix(err.Error(), want) {
+		t.Errorf("got error %v, want prefix %q", err, want)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{value: "", ok: false},
+		{value: "5", want: 5 * time.Second, ok: true},
+		{value: "-1", ok: false},
+		{value: "Wed, 01 Jan 2025 12:00:30 GMT", want: 30 * time.Second, ok: true},
+		{value: "Wed, 01 Jan 2025 11:00:00 GMT", want: 0, ok: true},
+		{value: "soon", ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value, now)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %s, %t; want %s, %t", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func newTestConfigClient(t *testing.T, server *httptest.Server, config Config) *Client {
+	t.Helper()
+	testClient := newTestClient(t, server)
+	config.bastionScheme = testClient.bastionScheme
+	config.bastionHosts = testClient.bastionNodes
+	config.bastionAPIVersion = testClient.bastionAPIVersion
+	client, diags := config.Client(context.Background())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	return client
+}
+
+func TestClientTLSVerification(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	caCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	sum := sha256.Sum256(server.Certificate().Raw)
+
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{
+			name:    "unknown authority",
+			config:  Config{},
+			wantErr: "verifying TLS certificate",
+		},
+		{
+			name:   "ca_cert_pem",
+			config: Config{caCertPEM: caCertPEM},
+		},
+		{
+			name:   "server_cert_fingerprint",
+			config: Config{serverCertFingerprint: hex.EncodeToString(sum[:])},
+		},
+		{
+			name:    "server_cert_fingerprint mismatch",
+			config:  Config{serverCertFingerprint: strings.Repeat("00", sha256.Size)},
+			wantErr: errServerCertFingerprint.Error(),
+		},
+		{
+			name:   "insecure_skip_verify",
+			config: Config{insecureSkipVerify: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestConfigClient(t, server, tt.config)
+			_, code, err := client.newRequest(context.Background(), "/users/", http.MethodGet, nil)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want it to contain %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if code != http.StatusNoContent {
+				t.Errorf("got status %d, want %d", code, http.StatusNoContent)
+			}
+		})
+	}
+}
+
+func TestConfigTLSConfigInvalid(t *testing.T) {
+	for _, config := range []Config{
+		{caCertPEM: "not a certificate"},
+		{caCertFile: "/nonexistent/ca.pem"},
+		{serverCertFingerprint: "abcd"},
+	} {
+		if _, diags := config.Client(context.Background()); !diags.HasError() {
+			t.Errorf("expected an error for config %+v", config)
+		}
+	}
+}
@@ -1,29 +1,169 @@
 package bastion
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"golang.org/x/net/http/httpproxy"
 )
 
+var errServerCertFingerprint = errors.New("server certificate SHA-256 fingerprint doesn't match server_cert_fingerprint")
+
 // Config: provider config.
 type Config struct {
-	bastionPort       int
-	bastionAPIVersion string
-	bastionIP         string
-	bastionToken      string
-	bastionUser       string
-	bastionPwd        string
+	bastionScheme         string
+	bastionPort           int
+	bastionAPIVersion     string
+	bastionIP             string
+	bastionHosts          []string
+	bastionToken          string
+	bastionUser           string
+	bastionPwd            string
+	maxRetries            int
+	retryMaxWait          time.Duration
+	caCertFile            string
+	caCertPEM             string
+	serverCertFingerprint string
+	insecureSkipVerify    bool
+	clientCertPEM         string
+	clientKeyPEM          string
+	proxyURL              string
+	noProxy               string
+	authMode              string
 }
 
 // Client: read information to connect on wallix bastion.
-func (c *Config) Client() (*Client, diag.Diagnostics) {
+func (c *Config) Client(ctx context.Context) (*Client, diag.Diagnostics) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy, err = c.proxyFunc()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 	cl := &Client{
-		bastionIP:         c.bastionIP,
-		bastionPort:       c.bastionPort,
+		bastionScheme:     c.bastionScheme,
+		bastionNodes:      bastionNodes(c.bastionIP, c.bastionHosts, c.bastionPort),
 		bastionToken:      c.bastionToken,
 		bastionUser:       c.bastionUser,
 		bastionAPIVersion: c.bastionAPIVersion,
 		bastionPwd:        c.bastionPwd,
+		maxRetries:        c.maxRetries,
+		retryMinWait:      defaultRetryMinWait,
+		retryMaxWait:      c.retryMaxWait,
+		httpClient:        &http.Client{Transport: transport},
+	}
+	if c.authMode == AuthModeSession {
+		cl.session = newSession()
+		registerSessionClient(cl)
 	}
+	if cl.bastionAPIVersion == VersionWallixAPIAuto {
+		cl.bastionAPIVersion, err = cl.detectAPIVersion(ctx)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+	cl.capabilities = apiCapabilities(cl.bastionAPIVersion)
 
 	return cl, nil
 }
+
+// tlsConfig builds the TLS configuration used to verify the bastion certificate.
+// A pinned fingerprint replaces the chain verification, which allows to trust
+// the self-signed certificate of an appliance without its CA.
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.clientCertPEM != "" || c.clientKeyPEM != "" {
+		clientCert, err := tls.X509KeyPair([]byte(c.clientCertPEM), []byte(c.clientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("loading client_cert_pem and client_key_pem: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+	if c.insecureSkipVerify {
+		tlsConfig.InsecureSkipVerify = true
+
+		return tlsConfig, nil
+	}
+	if c.caCertFile != "" || c.caCertPEM != "" {
+		caCert := []byte(c.caCertPEM)
+		if c.caCertFile != "" {
+			var err error
+			caCert, err = os.ReadFile(c.caCertFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading ca_cert_file: %w", err)
+			}
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("no valid PEM certificate found in ca_cert_file or ca_cert_pem")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.serverCertFingerprint != "" {
+		fingerprint, err := hex.DecodeString(strings.ReplaceAll(c.serverCertFingerprint, ":", ""))
+		if err != nil || len(fingerprint) != sha256.Size {
+			return nil, errors.New("server_cert_fingerprint must be a SHA-256 hex digest")
+		}
+		tlsConfig.InsecureSkipVerify = true //nolint: gosec
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return errServerCertFingerprint
+			}
+			sum := sha256.Sum256(state.PeerCertificates[0].Raw)
+			if !bytes.Equal(sum[:], fingerprint) {
+				return fmt.Errorf("%w: got %s", errServerCertFingerprint, hex.EncodeToString(sum[:]))
+			}
+
+			return nil
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// proxyFunc returns the proxy selection of the transport: proxy_url and
+// no_proxy when they are set, otherwise the HTTPS_PROXY, HTTP_PROXY and
+// NO_PROXY environment variables.
+func (c *Config) proxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	proxyConfig := httpproxy.FromEnvironment()
+	if c.proxyURL != "" {
+		proxyURL, err := url.Parse(c.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing proxy_url: %w", err)
+		}
+		if !slices.Contains([]string{"http", "https", "socks5"}, proxyURL.Scheme) || proxyURL.Host == "" {
+			return nil, fmt.Errorf("proxy_url must be an http, https or socks5 URL, got %q", c.proxyURL)
+		}
+		proxyConfig.HTTPProxy = c.proxyURL
+		proxyConfig.HTTPSProxy = c.proxyURL
+	}
+	if c.noProxy != "" {
+		proxyConfig.NoProxy = c.noProxy
+	}
+	proxy := proxyConfig.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
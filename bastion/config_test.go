@@ -0,0 +1,133 @@
+package bastion
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestConfigProxyURL(t *testing.T) {
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.Host
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer proxy.Close()
+	t.Setenv("HTTPS_PROXY", "")
+	t.Setenv("HTTP_PROXY", "")
+	t.Setenv("NO_PROXY", "")
+
+	config := Config{
+		bastionScheme:     "http",
+		bastionIP:         "bastion.example.com",
+		bastionPort:       80,
+		bastionAPIVersion: VersionWallixAPI38,
+		proxyURL:          proxy.URL,
+	}
+	client, diags := config.Client(context.Background())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	_, code, err := client.newRequest(context.Background(), "/users/", http.MethodGet, nil)
+	if err != nil || code != http.StatusOK {
+		t.Fatalf("unexpected result: %d, %v", code, err)
+	}
+	if proxiedHost != "bastion.example.com:80" {
+		t.Errorf("request not sent through proxy_url, proxy got host %q", proxiedHost)
+	}
+
+	config.noProxy = "localhost,.example.com"
+	proxyFunc, err := config.proxyFunc()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &http.Request{URL: &url.URL{Scheme: "https", Host: "bastion.example.com:443"}}
+	if proxyURL, err := proxyFunc(req); err != nil || proxyURL != nil {
+		t.Errorf("host in no_proxy sent through proxy %v (%v)", proxyURL, err)
+	}
+	req.URL.Host = "bastion.example.org:443"
+	if proxyURL, err := proxyFunc(req); err != nil || proxyURL == nil || proxyURL.String() != proxy.URL {
+		t.Errorf("got proxy %v (%v), want %s", proxyURL, err, proxy.URL)
+	}
+
+	for _, invalid := range []string{"ftp://proxy:21", "proxy:3128"} {
+		config := Config{proxyURL: invalid}
+		if _, diags := config.Client(context.Background()); !diags.HasError() {
+			t.Errorf("expected an error for proxy_url %q", invalid)
+		}
+	}
+}
+
+func newTestClientCert(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func TestConfigClientCert(t *testing.T) {
+	certPEM, keyPEM := newTestClientCert(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM([]byte(certPEM))
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	client := newTestConfigClient(t, server, Config{
+		insecureSkipVerify: true,
+		clientCertPEM:      certPEM,
+		clientKeyPEM:       keyPEM,
+	})
+	if _, code, err := client.newRequest(context.Background(), "/users/", http.MethodGet, nil); err != nil ||
+		code != http.StatusNoContent {
+		t.Errorf("unexpected result with client certificate: %d, %v", code, err)
+	}
+
+	client = newTestConfigClient(t, server, Config{insecureSkipVerify: true})
+	client.maxRetries = 0
+	if _, _, err := client.newRequest(context.Background(), "/users/", http.MethodGet, nil); err == nil {
+		t.Error("expected an error without client certificate")
+	}
+
+	config := Config{clientCertPEM: certPEM}
+	if _, diags := config.Client(context.Background()); !diags.HasError() {
+		t.Error("expected an error with client_cert_pem without client_key_pem")
+	}
+}
@@ -0,0 +1,76 @@
+package bastion
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	credentialFingerprintDescription = "Fingerprint of the id, type and public_key reported by the bastion. " +
+		"The API returns neither the password nor a date of its last change, " +
+		"so a password changed on the bastion isn't detected."
+	credentialIgnoreRotationDescription = "Don't write again an ssh_key credential changed on the bastion. " +
+		"Not available for a password credential, as its change isn't detected."
+)
+
+// credentialFingerprint returns a non-reversible fingerprint of what the
+// bastion reports for a credential, salted with its ID.
+// The API never returns the password, the private key or the passphrase, so
+// only the public key of an SSH key reveals a change of the credential: the API
+// reports no date of the last change either.
+func credentialFingerprint(jsonData jsonCredential) string {
+	sum := sha256.Sum256([]byte(jsonData.ID + "\n" + jsonData.Type + "\n" + jsonData.PublicKey))
+
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// fillCredentialRotation compares the fingerprint of the credential reported
+// by the bastion with the one of the last credential written and sets rotated
+// on a change, unless ignore_rotation adopts it.
+// An empty fingerprint, after a write, an import or with a state from an older
+// version of the provider, adopts the credential reported.
+func fillCredentialRotation(d *schema.ResourceData, jsonData jsonCredential) {
+	fingerprint := credentialFingerprint(jsonData)
+	rotated := false
+	switch {
+	case d.Get("fingerprint").(string) == fingerprint:
+	case d.Get("fingerprint").(string) == "", d.Get("ignore_rotation").(bool):
+		if tfErr := d.Set("fingerprint", fingerprint); tfErr != nil {
+			panic(tfErr)
+		}
+	default:
+		rotated = true
+	}
+	if tfErr := d.Set("rotated", rotated); tfErr != nil {
+		panic(tfErr)
+	}
+}
+
+// resetCredentialRotation forgets the fingerprint after a write so that the
+// next read adopts the credential written.
+func resetCredentialRotation(d *schema.ResourceData) {
+	if tfErr := d.Set("fingerprint", ""); tfErr != nil {
+		panic(tfErr)
+	}
+}
+
+// credentialRotationCustomizeDiff plans an update to write the credential
+// again when it has been rotated on the bastion, and rejects ignore_rotation
+// for a password, whose rotation is never detected.
+func credentialRotationCustomizeDiff(
+	_ context.Context, d *schema.ResourceDiff, _ interface{},
+) error {
+	if d.Get("ignore_rotation").(bool) && d.Get("type").(string) == "password" {
+		return errors.New("ignore_rotation is only available with type ssh_key, " +
+			"as a password changed on the bastion isn't detected")
+	}
+	if d.Id() != "" && d.Get("rotated").(bool) && !d.Get("ignore_rotation").(bool) {
+		return d.SetNew("rotated", false)
+	}
+
+	return nil
+}
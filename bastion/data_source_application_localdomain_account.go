@@ -0,0 +1,84 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceApplicationLocalDomainAccount() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceApplicationLocalDomainAccount().Schema)
+	for _, k := range []string{"application_id", "domain_id", "account_name"} {
+		dataSchema[k] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+	dataSchema["credentials"] = dataSourceSchemaComputed(resourceDomainAccount().Schema["credentials"])
+
+	return &schema.Resource{
+		ReadContext: dataSourceApplicationLocalDomainAccountRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceApplicationLocalDomainAccountVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_application_localdomain_account not available with api version %s", version)
+}
+
+func dataSourceApplicationLocalDomainAccountRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceApplicationLocalDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	applicationID := d.Get("application_id").(string)
+	domainID := d.Get("domain_id").(string)
+	id, ex, err := searchResourceApplicationLocalDomainAccount(ctx, applicationID, domainID, d.Get("account_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("account_name %s doesn't exists", d.Get("account_name").(string)))
+	}
+	cfg, err := readApplicationLocalDomainAccountOptions(ctx, applicationID, domainID, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("account with ID %s doesn't exists", id))
+	}
+	fillApplicationLocalDomainAccountCredentials(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
+
+// fillApplicationLocalDomainAccountCredentials also sets the types of the
+// credentials of the account, without their secrets.
+func fillApplicationLocalDomainAccountCredentials(
+	d *schema.ResourceData, jsonData jsonApplicationLocalDomainAccount,
+) {
+	fillApplicationLocalDomainAccount(d, jsonData)
+	credentials := make([]map[string]interface{}, len(jsonData.Credentials))
+	for i, v := range jsonData.Credentials {
+		credentials[i] = map[string]interface{}{
+			"id":         v.ID,
+			"public_key": v.PublicKey,
+			"type":       v.Type,
+		}
+	}
+	if tfErr := d.Set("credentials", credentials); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,91 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceApplicationLocalDomainAccount_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApplicationLocalDomainAccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_application_localdomain_account.testacc_dataAppLocalDomAccount", "id",
+						"wallix-bastion_application_localdomain_account.testacc_dataAppLocalDomAccount.0", "id"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_application_localdomain_account.testacc_dataAppLocalDomAccount",
+						"account_login", "login1"),
+					resource.TestCheckNoResourceAttr(
+						"data.wallix-bastion_application_localdomain_account.testacc_dataAppLocalDomAccount",
+						"password"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+// nolint: lll, nolintlint
+func testAccDataSourceApplicationLocalDomainAccountConfig() string {
+	return `
+resource "wallix-bastion_device" "testacc_dataAppLocalDomAccount" {
+  device_name = "testacc_dataAppLocalDomAccount"
+  host        = "testacc_dataAppLocalDomAccount"
+}
+
+resource "wallix-bastion_device_service" "testacc_dataAppLocalDomAccount" {
+  device_id         = wallix-bastion_device.testacc_dataAppLocalDomAccount.id
+  service_name      = "testacc_dataAppLocalDomAccount"
+  connection_policy = "RDP"
+  port              = 3389
+  protocol          = "RDP"
+  subprotocols      = ["RDP_CLIPBOARD_UP", "RDP_CLIPBOARD_DOWN"]
+}
+
+resource "wallix-bastion_cluster" "testacc_dataAppLocalDomAccount" {
+  cluster_name = "testacc_dataAppLocalDomAccount"
+  interactive_logins = [
+    "${wallix-bastion_device.testacc_dataAppLocalDomAccount.device_name}:${wallix-bastion_device_service.testacc_dataAppLocalDomAccount.service_name}",
+  ]
+}
+
+resource "wallix-bastion_application" "testacc_dataAppLocalDomAccount" {
+  application_name  = "testacc_dataAppLocalDomAccount"
+  connection_policy = "RDP"
+  paths {
+    target      = "Interactive@${wallix-bastion_device.testacc_dataAppLocalDomAccount.device_name}:${wallix-bastion_device_service.testacc_dataAppLocalDomAccount.service_name}"
+    program     = "application_path"
+    working_dir = "directory"
+  }
+  target = wallix-bastion_cluster.testacc_dataAppLocalDomAccount.cluster_name
+}
+
+resource "wallix-bastion_application_localdomain" "testacc_dataAppLocalDomAccount" {
+  application_id = wallix-bastion_application.testacc_dataAppLocalDomAccount.id
+  domain_name    = "testacc_dataAppLocalDomAccount"
+}
+
+resource "wallix-bastion_application_localdomain_account" "testacc_dataAppLocalDomAccount" {
+  count = 2
+
+  application_id = wallix-bastion_application.testacc_dataAppLocalDomAccount.id
+  domain_id      = wallix-bastion_application_localdomain.testacc_dataAppLocalDomAccount.id
+  account_name   = "testacc_dataAppLocalDomAccount${count.index + 1}"
+  account_login  = "login${count.index + 1}"
+  password       = "testacc_dataAppLocalDomAccount"
+}
+
+data "wallix-bastion_application_localdomain_account" "testacc_dataAppLocalDomAccount" {
+  application_id = wallix-bastion_application.testacc_dataAppLocalDomAccount.id
+  domain_id      = wallix-bastion_application_localdomain.testacc_dataAppLocalDomAccount.id
+  account_name   = "testacc_dataAppLocalDomAccount1"
+
+  depends_on = [wallix-bastion_application_localdomain_account.testacc_dataAppLocalDomAccount]
+}
+`
+}
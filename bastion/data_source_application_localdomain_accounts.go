@@ -0,0 +1,92 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceApplicationLocalDomainAccounts() *schema.Resource {
+	dataSchema := map[string]*schema.Schema{
+		"account_names": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"accounts": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: dataSourceApplicationLocalDomainAccountsElemSchema(),
+			},
+		},
+	}
+	for _, k := range []string{"application_id", "domain_id"} {
+		dataSchema[k] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceApplicationLocalDomainAccountsRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceApplicationLocalDomainAccountsElemSchema() map[string]*schema.Schema {
+	elemSchema := dataSourceSchemaFromResource(resourceApplicationLocalDomainAccount().Schema, "application_id", "domain_id")
+	elemSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	elemSchema["credentials"] = dataSourceSchemaComputed(resourceDomainAccount().Schema["credentials"])
+
+	return elemSchema
+}
+
+func dataSourceApplicationLocalDomainAccountsVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_application_localdomain_accounts not available with api version %s", version)
+}
+
+func dataSourceApplicationLocalDomainAccountsRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceApplicationLocalDomainAccountsVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	path := "/applications/" + d.Get("application_id").(string) +
+		"/localdomains/" + d.Get("domain_id").(string) + "/accounts/"
+	query := url.Values{"sort": []string{"account_name"}}
+	accounts, err := listResourcesAs[jsonApplicationLocalDomainAccount](ctx, path, query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	elemSchema := dataSourceApplicationLocalDomainAccountsElemSchema()
+	accountNames := make([]string, len(accounts))
+	list := make([]map[string]interface{}, len(accounts))
+	for i, v := range accounts {
+		accountNames[i] = v.AccountName
+		list[i] = flattenWithFill(elemSchema, fillApplicationLocalDomainAccountCredentials, v.ID, v)
+	}
+	if tfErr := d.Set("account_names", accountNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("accounts", list); tfErr != nil {
+		panic(tfErr)
+	}
+	d.SetId(path + "?" + query.Encode())
+
+	return nil
+}
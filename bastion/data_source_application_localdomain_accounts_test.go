@@ -0,0 +1,89 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceApplicationLocalDomainAccounts_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApplicationLocalDomainAccountsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_application_localdomain_accounts.testacc_dataAppLocalDomAccounts",
+						"account_names.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_application_localdomain_accounts.testacc_dataAppLocalDomAccounts",
+						"accounts.1.account_name", "testacc_dataAppLocalDomAccounts2"),
+					resource.TestCheckNoResourceAttr(
+						"data.wallix-bastion_application_localdomain_accounts.testacc_dataAppLocalDomAccounts",
+						"accounts.0.password"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+// nolint: lll, nolintlint
+func testAccDataSourceApplicationLocalDomainAccountsConfig() string {
+	return `
+resource "wallix-bastion_device" "testacc_dataAppLocalDomAccounts" {
+  device_name = "testacc_dataAppLocalDomAccounts"
+  host        = "testacc_dataAppLocalDomAccounts"
+}
+
+resource "wallix-bastion_device_service" "testacc_dataAppLocalDomAccounts" {
+  device_id         = wallix-bastion_device.testacc_dataAppLocalDomAccounts.id
+  service_name      = "testacc_dataAppLocalDomAccounts"
+  connection_policy = "RDP"
+  port              = 3389
+  protocol          = "RDP"
+  subprotocols      = ["RDP_CLIPBOARD_UP", "RDP_CLIPBOARD_DOWN"]
+}
+
+resource "wallix-bastion_cluster" "testacc_dataAppLocalDomAccounts" {
+  cluster_name = "testacc_dataAppLocalDomAccounts"
+  interactive_logins = [
+    "${wallix-bastion_device.testacc_dataAppLocalDomAccounts.device_name}:${wallix-bastion_device_service.testacc_dataAppLocalDomAccounts.service_name}",
+  ]
+}
+
+resource "wallix-bastion_application" "testacc_dataAppLocalDomAccounts" {
+  application_name  = "testacc_dataAppLocalDomAccounts"
+  connection_policy = "RDP"
+  paths {
+    target      = "Interactive@${wallix-bastion_device.testacc_dataAppLocalDomAccounts.device_name}:${wallix-bastion_device_service.testacc_dataAppLocalDomAccounts.service_name}"
+    program     = "application_path"
+    working_dir = "directory"
+  }
+  target = wallix-bastion_cluster.testacc_dataAppLocalDomAccounts.cluster_name
+}
+
+resource "wallix-bastion_application_localdomain" "testacc_dataAppLocalDomAccounts" {
+  application_id = wallix-bastion_application.testacc_dataAppLocalDomAccounts.id
+  domain_name    = "testacc_dataAppLocalDomAccounts"
+}
+
+resource "wallix-bastion_application_localdomain_account" "testacc_dataAppLocalDomAccounts" {
+  count = 2
+
+  application_id = wallix-bastion_application.testacc_dataAppLocalDomAccounts.id
+  domain_id      = wallix-bastion_application_localdomain.testacc_dataAppLocalDomAccounts.id
+  account_name   = "testacc_dataAppLocalDomAccounts${count.index + 1}"
+  account_login  = "login${count.index + 1}"
+  password       = "testacc_dataAppLocalDomAccounts"
+}
+data "wallix-bastion_application_localdomain_accounts" "testacc_dataAppLocalDomAccounts" {
+  application_id = wallix-bastion_application.testacc_dataAppLocalDomAccounts.id
+  domain_id      = wallix-bastion_application_localdomain.testacc_dataAppLocalDomAccounts.id
+
+  depends_on = [wallix-bastion_application_localdomain_account.testacc_dataAppLocalDomAccounts]
+}
+`
+}
@@ -0,0 +1,210 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type jsonApproval struct {
+	Duration   int                  `json:"duration"`
+	Quorum     int                  `json:"quorum"`
+	ID         string               `json:"id"`
+	UserName   string               `json:"user_name"`
+	TargetName string               `json:"target_name"`
+	Creation   string               `json:"creation"`
+	Begin      string               `json:"begin"`
+	End        string               `json:"end"`
+	Ticket     string               `json:"ticket"`
+	Comment    string               `json:"comment"`
+	Email      string               `json:"email"`
+	Language   string               `json:"language"`
+	Status     string               `json:"status"`
+	Answers    []jsonApprovalAnswer `json:"answers"`
+}
+
+type jsonApprovalAnswer struct {
+	Approved      bool   `json:"approved"`
+	ApproverName  string `json:"approver_name"`
+	ApproverEmail string `json:"approver_email"`
+	AnswerDate    string `json:"answer_date"`
+	Comment       string `json:"comment"`
+}
+
+func dataSourceApprovals() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApprovalsRead,
+		Schema: map[string]*schema.Schema{
+			"to_answer": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"target_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"sort": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "creation",
+				ValidateFunc: validation.StringInSlice([]string{
+					"creation", "begin", "end", "status", "user_name", "target_name",
+				}, false),
+			},
+			"sort_descending": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"approval_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"approvals": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceApprovalsElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApprovalsElemSchema() map[string]*schema.Schema {
+	computed := func(t schema.ValueType) *schema.Schema {
+		return &schema.Schema{Type: t, Computed: true}
+	}
+
+	return map[string]*schema.Schema{
+		"id":          computed(schema.TypeString),
+		"user_name":   computed(schema.TypeString),
+		"target_name": computed(schema.TypeString),
+		"creation":    computed(schema.TypeString),
+		"begin":       computed(schema.TypeString),
+		"end":         computed(schema.TypeString),
+		"duration":    computed(schema.TypeInt),
+		"ticket":      computed(schema.TypeString),
+		"comment":     computed(schema.TypeString),
+		"email":       computed(schema.TypeString),
+		"language":    computed(schema.TypeString),
+		"status":      computed(schema.TypeString),
+		"quorum":      computed(schema.TypeInt),
+		"answers": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"approved":       computed(schema.TypeBool),
+					"approver_name":  computed(schema.TypeString),
+					"approver_email": computed(schema.TypeString),
+					"answer_date":    computed(schema.TypeString),
+					"comment":        computed(schema.TypeString),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApprovalsVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_approvals not available with api version %s", version)
+}
+
+func dataSourceApprovalsRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceApprovalsVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	filters := make(map[string]string)
+	for _, k := range []string{"status", "user_name", "target_name"} {
+		if v := d.Get(k).(string); v != "" {
+			filters[k] = v
+		}
+	}
+	sort := d.Get("sort").(string)
+	if d.Get("sort_descending").(bool) {
+		sort = "-" + sort
+	}
+	query := url.Values{"sort": []string{sort}}
+	// approvals to answer are the pending ones assigned to the provider user
+	path := "/approvals/"
+	if d.Get("to_answer").(bool) {
+		path = "/approvals/assignments/"
+	}
+	approvals, err := searchResourcesAs[jsonApproval](ctx, path, filters, query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillApprovals(d, approvals)
+	d.SetId(strings.Trim(path, "/") + "?" + query.Encode())
+
+	return nil
+}
+
+func fillApprovals(d *schema.ResourceData, approvals []jsonApproval) {
+	approvalIDs := make([]string, len(approvals))
+	list := make([]map[string]interface{}, len(approvals))
+	for i, v := range approvals {
+		approvalIDs[i] = v.ID
+		list[i] = flattenApproval(v)
+	}
+	if tfErr := d.Set("approval_ids", approvalIDs); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("approvals", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
+
+func flattenApproval(approval jsonApproval) map[string]interface{} {
+	answers := make([]map[string]interface{}, len(approval.Answers))
+	for i, v := range approval.Answers {
+		answers[i] = map[string]interface{}{
+			"approved":       v.Approved,
+			"approver_name":  v.ApproverName,
+			"approver_email": v.ApproverEmail,
+			"answer_date":    v.AnswerDate,
+			"comment":        v.Comment,
+		}
+	}
+
+	return map[string]interface{}{
+		"id":          approval.ID,
+		"user_name":   approval.UserName,
+		"target_name": approval.TargetName,
+		"creation":    approval.Creation,
+		"begin":       approval.Begin,
+		"end":         approval.End,
+		"duration":    approval.Duration,
+		"ticket":      approval.Ticket,
+		"comment":     approval.Comment,
+		"email":       approval.Email,
+		"language":    approval.Language,
+		"status":      approval.Status,
+		"quorum":      approval.Quorum,
+		"answers":     answers,
+	}
+}
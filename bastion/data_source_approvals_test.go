@@ -0,0 +1,48 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceApprovals_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApprovalsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.wallix-bastion_approvals.testacc_dataApprovals", "id"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_approvals.testacc_dataApprovalsToAnswer", "approvals.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_approvals.testacc_dataApprovalsToAnswer", "approvals.0.user_name", "asmith"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_approvals.testacc_dataApprovalsToAnswer", "approvals.0.status", "pending"),
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_approvals.testacc_dataApprovalsToAnswer", "approval_ids.0",
+						"data.wallix-bastion_approvals.testacc_dataApprovalsToAnswer", "approvals.0.id"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceApprovalsConfig() string {
+	return `
+data "wallix-bastion_approvals" "testacc_dataApprovals" {
+  sort            = "creation"
+  sort_descending = true
+}
+
+data "wallix-bastion_approvals" "testacc_dataApprovalsToAnswer" {
+  to_answer   = true
+  user_name   = "asmith"
+  target_name = "admin@srv2:RDP"
+}
+`
+}
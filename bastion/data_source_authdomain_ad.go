@@ -60,18 +60,18 @@ func dataSourceAuthDomainADRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := dataSourceAuthDomainAdVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceAuthDomainAD(ctx, d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s doesn't exists", d.Get("domain_name").(string)))
+		return diagFromErr(fmt.Errorf("domain_name %s doesn't exists", d.Get("domain_name").(string)))
 	}
 	cfg, err := readAuthDomainADOptions(ctx, id, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	fillSourceAuthDomainAD(d, cfg)
 	d.SetId(id)
@@ -20,13 +20,13 @@ func TestAccDataSourceAuthDomainAD_basic(t *testing.T) {
 				// Validate that the datasource correctly retrieves the resource.
 				Config: testAccDataSourceAuthDomainADConfigData(),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain.testacc_dataDomain",
+					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain_ad.testacc_dataDomain",
 						"domain_name", "testacc-domain"),
-					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain.testacc_dataDomain",
+					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain_ad.testacc_dataDomain",
 						"auth_domain_name", "testacc-auth-domain"),
-					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain.testacc_dataDomain",
+					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain_ad.testacc_dataDomain",
 						"default_language", "en"),
-					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain.testacc_dataDomain",
+					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain_ad.testacc_dataDomain",
 						"default_email_domain", "example.com"),
 				),
 			},
@@ -58,9 +58,9 @@ resource "wallix-bastion_authdomain_ad" "testacc_dataAuthDomain" {
   external_auths       = ["auth1", "auth2"]
 }
 
-data "wallix-bastion_authdomain" "testacc_dataDomain" {
-  domain_name      = wallix-bastion_domain.testacc_dataDomain.domain_name
-  auth_domain_name = wallix-bastion_domain.testacc_dataDomain.domain_real_name
+data "wallix-bastion_authdomain_ad" "testacc_dataDomain" {
+  domain_name      = wallix-bastion_authdomain_ad.testacc_dataAuthDomain.domain_name
+  auth_domain_name = wallix-bastion_authdomain_ad.testacc_dataAuthDomain.auth_domain_name
 }
 `
 }
@@ -0,0 +1,64 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAuthDomainAzureAD() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceAuthDomainAzureAD().Schema, "domain_name")
+	dataSchema["domain_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceAuthDomainAzureADRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceAuthDomainAzureADVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_authdomain_azuread not available with api version %s", version)
+}
+
+func dataSourceAuthDomainAzureADRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceAuthDomainAzureADVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceAuthDomainAzureAD(ctx, d.Get("domain_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("domain_name %s doesn't exists", d.Get("domain_name").(string)))
+	}
+	cfg, err := readAuthDomainAzureADOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("auth domain with ID %s doesn't exists", id))
+	}
+	if cfg.Type != "AzureAD" {
+		return diagFromErr(fmt.Errorf("domain_name %s is a %s auth domain, not a AzureAD one",
+			d.Get("domain_name").(string), cfg.Type))
+	}
+	fillAuthDomainAzureAD(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAuthDomainLdap() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceAuthDomainLdap().Schema, "domain_name")
+	dataSchema["domain_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceAuthDomainLdapRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceAuthDomainLdapVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_authdomain_ldap not available with api version %s", version)
+}
+
+func dataSourceAuthDomainLdapRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceAuthDomainLdapVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceAuthDomainLdap(ctx, d.Get("domain_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("domain_name %s doesn't exists", d.Get("domain_name").(string)))
+	}
+	cfg, err := readAuthDomainLdapOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("auth domain with ID %s doesn't exists", id))
+	}
+	if cfg.Type != "LDAP" {
+		return diagFromErr(fmt.Errorf("domain_name %s is a %s auth domain, not a LDAP one",
+			d.Get("domain_name").(string), cfg.Type))
+	}
+	fillAuthDomainLdap(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
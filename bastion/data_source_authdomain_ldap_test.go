@@ -0,0 +1,56 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAuthDomainLdap_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAuthDomainLdapConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_authdomain_ldap.testacc_dataAuthDomainLDAP", "id",
+						"wallix-bastion_authdomain_ldap.testacc_dataAuthDomainLDAP", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain_ldap.testacc_dataAuthDomainLDAP",
+						"auth_domain_name", "test3.com"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain_ldap.testacc_dataAuthDomainLDAP",
+						"external_auths.0", "testacc_dataAuthDomainLDAP"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceAuthDomainLdapConfig() string {
+	return `
+resource "wallix-bastion_authdomain_ldap" "testacc_dataAuthDomainLDAP" {
+  domain_name          = "testacc.dataAuthDomainLDAP"
+  auth_domain_name     = "test3.com"
+  external_auths       = [wallix-bastion_externalauth_ldap.testacc_dataAuthDomainLDAP.authentication_name]
+  default_language     = "fr"
+  default_email_domain = "test3.com"
+}
+resource "wallix-bastion_externalauth_ldap" "testacc_dataAuthDomainLDAP" {
+  authentication_name = "testacc_dataAuthDomainLDAP"
+  cn_attribute        = "sAMAccountName"
+  host                = "server2"
+  ldap_base           = "OU=FR,DC=test,DC=com"
+  login_attribute     = "sAMAccountName"
+  port                = 636
+  timeout             = 10
+  is_ssl              = true
+  is_anonymous_access = true
+}
+
+data "wallix-bastion_authdomain_ldap" "testacc_dataAuthDomainLDAP" {
+  domain_name = wallix-bastion_authdomain_ldap.testacc_dataAuthDomainLDAP.domain_name
+}
+`
+}
@@ -0,0 +1,64 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAuthDomainSAML() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceAuthDomainSAML().Schema, "domain_name")
+	dataSchema["domain_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceAuthDomainSAMLRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceAuthDomainSAMLVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_authdomain_saml not available with api version %s", version)
+}
+
+func dataSourceAuthDomainSAMLRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceAuthDomainSAMLVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceAuthDomainSAML(ctx, d.Get("domain_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("domain_name %s doesn't exists", d.Get("domain_name").(string)))
+	}
+	cfg, err := readAuthDomainSAMLOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("auth domain with ID %s doesn't exists", id))
+	}
+	if cfg.Type != "SAML" {
+		return diagFromErr(fmt.Errorf("domain_name %s is a %s auth domain, not a SAML one",
+			d.Get("domain_name").(string), cfg.Type))
+	}
+	fillAuthDomainSAML(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAuthDomainSAML_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAuthDomainSAMLConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_authdomain_saml.testacc_dataAuthDomainSAML", "id",
+						"wallix-bastion_authdomain_saml.testacc_dataAuthDomainSAML", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain_saml.testacc_dataAuthDomainSAML",
+						"label", "SAML"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_authdomain_saml.testacc_dataAuthDomainSAML",
+						"default_language", "fr"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceAuthDomainSAMLConfig() string {
+	return `
+resource "wallix-bastion_authdomain_saml" "testacc_dataAuthDomainSAML" {
+  domain_name          = "testacc.dataAuthDomainSAML"
+  auth_domain_name     = "test5.com"
+  external_auths       = [wallix-bastion_externalauth_saml.testacc_dataAuthDomainSAML.authentication_name]
+  default_email_domain = "test5.com"
+  default_language     = "fr"
+  label                = "SAML"
+}
+resource "wallix-bastion_externalauth_saml" "testacc_dataAuthDomainSAML" {
+  authentication_name = "testacc_dataAuthDomainSAML"
+  idp_metadata        = local.idp_metadata
+  timeout             = 120
+}
+
+data "wallix-bastion_authdomain_saml" "testacc_dataAuthDomainSAML" {
+  domain_name = wallix-bastion_authdomain_saml.testacc_dataAuthDomainSAML.domain_name
+}
+` + testAccResourceAuthDomainSAMLIdpMetadata()
+}
@@ -0,0 +1,60 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAuthorization() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceAuthorization().Schema, "authorization_name")
+	dataSchema["authorization_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceAuthorizationRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceAuthorizationVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_authorization not available with api version %s", version)
+}
+
+func dataSourceAuthorizationRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceAuthorizationVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceAuthorization(ctx, d.Get("authorization_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("authorization_name %s doesn't exists", d.Get("authorization_name").(string)))
+	}
+	cfg, err := readAuthorizationOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("authorization with ID %s doesn't exists", id))
+	}
+	fillAuthorization(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
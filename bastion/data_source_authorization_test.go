@@ -0,0 +1,54 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAuthorization_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAuthorizationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_authorization.testacc_dataAuthorization", "id",
+						"wallix-bastion_authorization.testacc_dataAuthorization", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_authorization.testacc_dataAuthorization",
+						"user_group", "testacc_dataAuthorization"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_authorization.testacc_dataAuthorization",
+						"authorize_sessions", "true"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_authorization.testacc_dataAuthorization",
+						"subprotocols.#", "2"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceAuthorizationConfig() string {
+	return `
+resource "wallix-bastion_authorization" "testacc_dataAuthorization" {
+  authorization_name = "testacc_dataAuthorization"
+  user_group         = wallix-bastion_usergroup.testacc_dataAuthorization.group_name
+  target_group       = wallix-bastion_targetgroup.testacc_dataAuthorization.group_name
+  authorize_sessions = true
+  subprotocols       = ["SSH_SHELL_SESSION", "RDP"]
+}
+resource "wallix-bastion_usergroup" "testacc_dataAuthorization" {
+  group_name = "testacc_dataAuthorization"
+  timeframes = ["allthetime"]
+}
+resource "wallix-bastion_targetgroup" "testacc_dataAuthorization" {
+  group_name = "testacc_dataAuthorization"
+}
+
+data "wallix-bastion_authorization" "testacc_dataAuthorization" {
+  authorization_name = wallix-bastion_authorization.testacc_dataAuthorization.authorization_name
+}
+`
+}
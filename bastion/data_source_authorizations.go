@@ -0,0 +1,100 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAuthorizations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuthorizationsRead,
+		Schema: map[string]*schema.Schema{
+			"user_group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"target_group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"authorization_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"authorizations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceAuthorizationsElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuthorizationsElemSchema() map[string]*schema.Schema {
+	elemSchema := dataSourceSchemaFromResource(resourceAuthorization().Schema)
+	elemSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return elemSchema
+}
+
+func dataSourceAuthorizationsVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_authorizations not available with api version %s", version)
+}
+
+func dataSourceAuthorizationsRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceAuthorizationsVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	filters := make(map[string]string)
+	for _, k := range []string{"user_group", "target_group"} {
+		if v := d.Get(k).(string); v != "" {
+			filters[k] = v
+		}
+	}
+	query := url.Values{"sort": []string{"authorization_name"}}
+	authorizations, err := searchResourcesAs[jsonAuthorization](ctx, "/authorizations/", filters, query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillAuthorizations(d, authorizations)
+	d.SetId("authorizations?" + query.Encode())
+
+	return nil
+}
+
+func fillAuthorizations(d *schema.ResourceData, authorizations []jsonAuthorization) {
+	elemSchema := dataSourceAuthorizationsElemSchema()
+	authorizationNames := make([]string, len(authorizations))
+	list := make([]map[string]interface{}, len(authorizations))
+	for i, v := range authorizations {
+		authorizationNames[i] = v.AuthorizationName
+		list[i] = flattenWithFill(elemSchema, fillAuthorization, v.ID, v)
+	}
+	if tfErr := d.Set("authorization_names", authorizationNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("authorizations", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,64 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAuthorizations_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAuthorizationsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wallix-bastion_authorizations.testacc_dataAuthorizations",
+						"authorization_names.#", "1"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_authorizations.testacc_dataAuthorizations",
+						"authorizations.0.authorization_name", "testacc_dataAuthorizations2"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_authorizations.testacc_dataAuthorizations",
+						"authorizations.0.authorize_password_retrieval", "true"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_authorizations.testacc_dataAuthorizationsUser",
+						"authorization_names.#", "2"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceAuthorizationsConfig() string {
+	return `
+resource "wallix-bastion_authorization" "testacc_dataAuthorizations" {
+  count = 2
+
+  authorization_name           = "testacc_dataAuthorizations${count.index + 1}"
+  user_group                   = wallix-bastion_usergroup.testacc_dataAuthorizations.group_name
+  target_group                 = wallix-bastion_targetgroup.testacc_dataAuthorizations[count.index].group_name
+  authorize_password_retrieval = true
+}
+resource "wallix-bastion_usergroup" "testacc_dataAuthorizations" {
+  group_name = "testacc_dataAuthorizations"
+  timeframes = ["allthetime"]
+}
+resource "wallix-bastion_targetgroup" "testacc_dataAuthorizations" {
+  count = 2
+
+  group_name = "testacc_dataAuthorizations${count.index + 1}"
+}
+
+data "wallix-bastion_authorizations" "testacc_dataAuthorizations" {
+  target_group = "testacc_dataAuthorizations2"
+
+  depends_on = [wallix-bastion_authorization.testacc_dataAuthorizations]
+}
+
+data "wallix-bastion_authorizations" "testacc_dataAuthorizationsUser" {
+  user_group = wallix-bastion_usergroup.testacc_dataAuthorizations.group_name
+
+  depends_on = [wallix-bastion_authorization.testacc_dataAuthorizations]
+}
+`
+}
@@ -0,0 +1,83 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCheckoutPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCheckoutPoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"checkout_policy_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"checkout_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceCheckoutPoliciesElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCheckoutPoliciesElemSchema() map[string]*schema.Schema {
+	elemSchema := dataSourceSchemaFromResource(resourceCheckoutPolicy().Schema)
+	elemSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return elemSchema
+}
+
+func dataSourceCheckoutPoliciesVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_checkout_policies not available with api version %s", version)
+}
+
+func dataSourceCheckoutPoliciesRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceCheckoutPoliciesVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	query := url.Values{"sort": []string{"checkout_policy_name"}}
+	checkoutPolicies, err := listResourcesAs[jsonCheckoutPolicy](ctx, "/checkoutpolicies/", query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillCheckoutPolicies(d, checkoutPolicies)
+	d.SetId("checkoutpolicies?" + query.Encode())
+
+	return nil
+}
+
+func fillCheckoutPolicies(d *schema.ResourceData, checkoutPolicies []jsonCheckoutPolicy) {
+	elemSchema := dataSourceCheckoutPoliciesElemSchema()
+	checkoutPolicyNames := make([]string, len(checkoutPolicies))
+	list := make([]map[string]interface{}, len(checkoutPolicies))
+	for i, v := range checkoutPolicies {
+		checkoutPolicyNames[i] = v.CheckoutPolicyName
+		list[i] = flattenWithFill(elemSchema, fillCheckoutPolicy, v.ID, v)
+	}
+	if tfErr := d.Set("checkout_policy_names", checkoutPolicyNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("checkout_policies", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,45 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCheckoutPolicies_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCheckoutPoliciesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.wallix-bastion_checkout_policies.testacc_dataCheckoutPolicies",
+						"checkout_policy_names.*", "testacc_dataCheckoutPolicies1"),
+					resource.TestCheckTypeSetElemAttr("data.wallix-bastion_checkout_policies.testacc_dataCheckoutPolicies",
+						"checkout_policy_names.*", "testacc_dataCheckoutPolicies2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.wallix-bastion_checkout_policies.testacc_dataCheckoutPolicies", "checkout_policies.*",
+						map[string]string{
+							"checkout_policy_name": "testacc_dataCheckoutPolicies2",
+						}),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceCheckoutPoliciesConfig() string {
+	return `
+resource "wallix-bastion_checkout_policy" "testacc_dataCheckoutPolicies" {
+  count = 2
+
+  checkout_policy_name = "testacc_dataCheckoutPolicies${count.index + 1}"
+}
+
+data "wallix-bastion_checkout_policies" "testacc_dataCheckoutPolicies" {
+  depends_on = [wallix-bastion_checkout_policy.testacc_dataCheckoutPolicies]
+}
+`
+}
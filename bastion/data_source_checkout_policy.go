@@ -0,0 +1,60 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCheckoutPolicy() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceCheckoutPolicy().Schema, "checkout_policy_name")
+	dataSchema["checkout_policy_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceCheckoutPolicyRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceCheckoutPolicyVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_checkout_policy not available with api version %s", version)
+}
+
+func dataSourceCheckoutPolicyRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceCheckoutPolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceCheckoutPolicy(ctx, d.Get("checkout_policy_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("checkout_policy_name %s doesn't exists", d.Get("checkout_policy_name").(string)))
+	}
+	cfg, err := readCheckoutPolicyOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("checkout policy with ID %s doesn't exists", id))
+	}
+	fillCheckoutPolicy(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
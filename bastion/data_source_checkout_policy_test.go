@@ -0,0 +1,45 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCheckoutPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCheckoutPolicyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_checkout_policy.testacc_dataCheckoutPolicy", "id",
+						"wallix-bastion_checkout_policy.testacc_dataCheckoutPolicy", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_checkout_policy.testacc_dataCheckoutPolicy",
+						"enable_lock", "true"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_checkout_policy.testacc_dataCheckoutPolicy",
+						"max_duration", "180"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceCheckoutPolicyConfig() string {
+	return `
+resource "wallix-bastion_checkout_policy" "testacc_dataCheckoutPolicy" {
+  checkout_policy_name = "testacc_dataCheckoutPolicy"
+  enable_lock          = true
+  duration             = 60
+  extension            = 60
+  max_duration         = 180
+}
+
+data "wallix-bastion_checkout_policy" "testacc_dataCheckoutPolicy" {
+  checkout_policy_name = wallix-bastion_checkout_policy.testacc_dataCheckoutPolicy.checkout_policy_name
+}
+`
+}
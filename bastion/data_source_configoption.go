@@ -69,11 +69,11 @@ func dataSourceConfigoptionRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := dataSourceConfigoptionVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readConfigoption(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	fillConfigoption(d, cfg)
 	d.SetId(cfg.ID)
@@ -101,7 +101,7 @@ func readConfigoption(
 		return result, err
 	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		return result, newAPIError(code, body)
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -0,0 +1,83 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceConnectionPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConnectionPoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"connection_policy_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"connection_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceConnectionPoliciesElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConnectionPoliciesElemSchema() map[string]*schema.Schema {
+	elemSchema := dataSourceSchemaFromResource(resourceConnectionPolicy().Schema)
+	elemSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return elemSchema
+}
+
+func dataSourceConnectionPoliciesVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_connection_policies not available with api version %s", version)
+}
+
+func dataSourceConnectionPoliciesRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceConnectionPoliciesVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	query := url.Values{"sort": []string{"connection_policy_name"}}
+	connectionPolicies, err := listResourcesAs[jsonConnectionPolicy](ctx, "/connectionpolicies/", query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillConnectionPolicies(d, connectionPolicies)
+	d.SetId("connectionpolicies?" + query.Encode())
+
+	return nil
+}
+
+func fillConnectionPolicies(d *schema.ResourceData, connectionPolicies []jsonConnectionPolicy) {
+	elemSchema := dataSourceConnectionPoliciesElemSchema()
+	connectionPolicyNames := make([]string, len(connectionPolicies))
+	list := make([]map[string]interface{}, len(connectionPolicies))
+	for i, v := range connectionPolicies {
+		connectionPolicyNames[i] = v.ConnectionPolicyName
+		list[i] = flattenWithFill(elemSchema, fillConnectionPolicy, v.ID, v)
+	}
+	if tfErr := d.Set("connection_policy_names", connectionPolicyNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("connection_policies", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,44 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceConnectionPolicies_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConnectionPoliciesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.wallix-bastion_connection_policies.testacc_dataConnectionPolicies",
+						"connection_policy_names.*", "SSH"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.wallix-bastion_connection_policies.testacc_dataConnectionPolicies", "connection_policies.*",
+						map[string]string{
+							"connection_policy_name": "testacc_dataConnectionPolicies",
+							"protocol":               "TELNET",
+						}),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceConnectionPoliciesConfig() string {
+	return `
+resource "wallix-bastion_connection_policy" "testacc_dataConnectionPolicies" {
+  connection_policy_name = "testacc_dataConnectionPolicies"
+  protocol               = "TELNET"
+  options                = jsonencode({})
+}
+
+data "wallix-bastion_connection_policies" "testacc_dataConnectionPolicies" {
+  depends_on = [wallix-bastion_connection_policy.testacc_dataConnectionPolicies]
+}
+`
+}
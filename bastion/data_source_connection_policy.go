@@ -0,0 +1,60 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceConnectionPolicy() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceConnectionPolicy().Schema, "connection_policy_name")
+	dataSchema["connection_policy_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceConnectionPolicyRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceConnectionPolicyVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_connection_policy not available with api version %s", version)
+}
+
+func dataSourceConnectionPolicyRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceConnectionPolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceConnectionPolicy(ctx, d.Get("connection_policy_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("connection_policy_name %s doesn't exists", d.Get("connection_policy_name").(string)))
+	}
+	cfg, err := readConnectionPolicyOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("connection policy with ID %s doesn't exists", id))
+	}
+	fillConnectionPolicy(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
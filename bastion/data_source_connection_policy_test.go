@@ -0,0 +1,50 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceConnectionPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConnectionPolicyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_connection_policy.testacc_dataConnectionPolicy", "id",
+						"wallix-bastion_connection_policy.testacc_dataConnectionPolicy", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_connection_policy.testacc_dataConnectionPolicy",
+						"protocol", "SSH"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_connection_policy.testacc_dataConnectionPolicy",
+						"description", "testacc dataConnectionPolicy"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_connection_policy.testacc_dataConnectionPolicyRDP",
+						"protocol", "RDP"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceConnectionPolicyConfig() string {
+	return `
+resource "wallix-bastion_connection_policy" "testacc_dataConnectionPolicy" {
+  connection_policy_name = "testacc_dataConnectionPolicy"
+  protocol               = "SSH"
+  options                = jsonencode({})
+  description            = "testacc dataConnectionPolicy"
+}
+
+data "wallix-bastion_connection_policy" "testacc_dataConnectionPolicy" {
+  connection_policy_name = wallix-bastion_connection_policy.testacc_dataConnectionPolicy.connection_policy_name
+}
+
+data "wallix-bastion_connection_policy" "testacc_dataConnectionPolicyRDP" {
+  connection_policy_name = "RDP"
+}
+`
+}
@@ -0,0 +1,90 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDevice() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDeviceRead,
+		Schema: map[string]*schema.Schema{
+			"device_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"device_name", "device_id"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"device_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"device_name", "device_id"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_domains": resourceDevice().Schema["local_domains"],
+			"services":      resourceDevice().Schema["services"],
+		},
+	}
+}
+
+func dataSourceDeviceVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_device not available with api version %s", version)
+}
+
+func dataSourceDeviceRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceDeviceVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Get("device_id").(string)
+	if id == "" {
+		var ex bool
+		var err error
+		id, ex, err = searchResourceDevice(ctx, d.Get("device_name").(string), m)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if !ex {
+			return diagFromErr(fmt.Errorf("device_name %s doesn't exists", d.Get("device_name").(string)))
+		}
+	}
+	cfg, err := readDeviceOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("device with ID %s doesn't exists", id))
+	}
+	fillDevice(d, cfg)
+	if tfErr := d.Set("device_id", cfg.ID); tfErr != nil {
+		panic(tfErr)
+	}
+	d.SetId(cfg.ID)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDeviceLocalDomainAccount() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceDeviceLocalDomainAccount().Schema)
+	for _, k := range []string{"device_id", "domain_id", "account_name"} {
+		dataSchema[k] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDeviceLocalDomainAccountRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceDeviceLocalDomainAccountVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_device_localdomain_account not available with api version %s", version)
+}
+
+func dataSourceDeviceLocalDomainAccountRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceDeviceLocalDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	deviceID := d.Get("device_id").(string)
+	domainID := d.Get("domain_id").(string)
+	id, ex, err := searchResourceDeviceLocalDomainAccount(ctx, deviceID, domainID, d.Get("account_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("account_name %s doesn't exists", d.Get("account_name").(string)))
+	}
+	cfg, err := readDeviceLocalDomainAccountOptions(ctx, deviceID, domainID, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("account with ID %s doesn't exists", id))
+	}
+	fillDeviceLocalDomainAccount(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDeviceLocalDomainAccount_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDeviceLocalDomainAccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_device_localdomain_account.testacc_dataDeviceLocalDomainAccount", "id",
+						"wallix-bastion_device_localdomain_account.testacc_dataDeviceLocalDomainAccount.0", "id"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_device_localdomain_account.testacc_dataDeviceLocalDomainAccount",
+						"description", "testacc dataDeviceLocalDomainAccount1"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceDeviceLocalDomainAccountConfig() string {
+	return `
+resource "wallix-bastion_device" "testacc_dataDeviceLocalDomainAccount" {
+  device_name = "testacc_dataDeviceLocalDomainAccount"
+  host        = "testacc_data_localdomain_account.device"
+}
+resource "wallix-bastion_device_localdomain" "testacc_dataDeviceLocalDomainAccount" {
+  device_id   = wallix-bastion_device.testacc_dataDeviceLocalDomainAccount.id
+  domain_name = "testacc_dataDeviceLocalDomainAccount"
+}
+resource "wallix-bastion_device_localdomain_account" "testacc_dataDeviceLocalDomainAccount" {
+  count = 2
+
+  device_id     = wallix-bastion_device.testacc_dataDeviceLocalDomainAccount.id
+  domain_id     = wallix-bastion_device_localdomain.testacc_dataDeviceLocalDomainAccount.id
+  account_name  = "testacc_dataDeviceLocalDomainAccount${count.index + 1}"
+  account_login = "login${count.index + 1}"
+  description   = "testacc dataDeviceLocalDomainAccount${count.index + 1}"
+}
+
+data "wallix-bastion_device_localdomain_account" "testacc_dataDeviceLocalDomainAccount" {
+  device_id    = wallix-bastion_device.testacc_dataDeviceLocalDomainAccount.id
+  domain_id    = wallix-bastion_device_localdomain.testacc_dataDeviceLocalDomainAccount.id
+  account_name = "testacc_dataDeviceLocalDomainAccount1"
+
+  depends_on = [wallix-bastion_device_localdomain_account.testacc_dataDeviceLocalDomainAccount]
+}
+`
+}
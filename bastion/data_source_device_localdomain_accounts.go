@@ -0,0 +1,91 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDeviceLocalDomainAccounts() *schema.Resource {
+	dataSchema := map[string]*schema.Schema{
+		"account_names": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"accounts": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: dataSourceDeviceLocalDomainAccountsElemSchema(),
+			},
+		},
+	}
+	for _, k := range []string{"device_id", "domain_id"} {
+		dataSchema[k] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDeviceLocalDomainAccountsRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceDeviceLocalDomainAccountsElemSchema() map[string]*schema.Schema {
+	elemSchema := dataSourceSchemaFromResource(resourceDeviceLocalDomainAccount().Schema, "device_id", "domain_id")
+	elemSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return elemSchema
+}
+
+func dataSourceDeviceLocalDomainAccountsVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_device_localdomain_accounts not available with api version %s", version)
+}
+
+func dataSourceDeviceLocalDomainAccountsRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceDeviceLocalDomainAccountsVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	path := "/devices/" + d.Get("device_id").(string) +
+		"/localdomains/" + d.Get("domain_id").(string) + "/accounts/"
+	query := url.Values{"sort": []string{"account_name"}}
+	accounts, err := listResourcesAs[jsonDeviceLocalDomainAccount](ctx, path, query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	elemSchema := dataSourceDeviceLocalDomainAccountsElemSchema()
+	accountNames := make([]string, len(accounts))
+	list := make([]map[string]interface{}, len(accounts))
+	for i, v := range accounts {
+		accountNames[i] = v.AccountName
+		list[i] = flattenWithFill(elemSchema, fillDeviceLocalDomainAccount, v.ID, v)
+	}
+	if tfErr := d.Set("account_names", accountNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("accounts", list); tfErr != nil {
+		panic(tfErr)
+	}
+	d.SetId(path + "?" + query.Encode())
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDeviceLocalDomainAccounts_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDeviceLocalDomainAccountsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_device_localdomain_accounts.testacc_dataDeviceLocalDomainAccounts",
+						"account_names.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_device_localdomain_accounts.testacc_dataDeviceLocalDomainAccounts",
+						"accounts.1.account_login", "login2"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceDeviceLocalDomainAccountsConfig() string {
+	return `
+resource "wallix-bastion_device" "testacc_dataDeviceLocalDomainAccounts" {
+  device_name = "testacc_dataDeviceLocalDomainAccounts"
+  host        = "testacc_data_localdomain_account.device"
+}
+resource "wallix-bastion_device_localdomain" "testacc_dataDeviceLocalDomainAccounts" {
+  device_id   = wallix-bastion_device.testacc_dataDeviceLocalDomainAccounts.id
+  domain_name = "testacc_dataDeviceLocalDomainAccounts"
+}
+resource "wallix-bastion_device_localdomain_account" "testacc_dataDeviceLocalDomainAccounts" {
+  count = 2
+
+  device_id     = wallix-bastion_device.testacc_dataDeviceLocalDomainAccounts.id
+  domain_id     = wallix-bastion_device_localdomain.testacc_dataDeviceLocalDomainAccounts.id
+  account_name  = "testacc_dataDeviceLocalDomainAccounts${count.index + 1}"
+  account_login = "login${count.index + 1}"
+  description   = "testacc dataDeviceLocalDomainAccount${count.index + 1}"
+}
+data "wallix-bastion_device_localdomain_accounts" "testacc_dataDeviceLocalDomainAccounts" {
+  device_id = wallix-bastion_device.testacc_dataDeviceLocalDomainAccounts.id
+  domain_id = wallix-bastion_device_localdomain.testacc_dataDeviceLocalDomainAccounts.id
+
+  depends_on = [wallix-bastion_device_localdomain_account.testacc_dataDeviceLocalDomainAccounts]
+}
+`
+}
@@ -0,0 +1,60 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDevice_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDeviceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_device.testacc_dataDevice", "id",
+						"wallix-bastion_device.testacc_dataDevice", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_device.testacc_dataDevice",
+						"host", "testacc_data.device"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_device.testacc_dataDevice",
+						"services.#", "1"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_device.testacc_dataDevice",
+						"services.0.port", "22"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_device.testacc_dataDeviceByID",
+						"device_name", "testacc_dataDevice"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceDeviceConfig() string {
+	return `
+resource "wallix-bastion_device" "testacc_dataDevice" {
+  device_name = "testacc_dataDevice"
+  host        = "testacc_data.device"
+}
+resource "wallix-bastion_device_service" "testacc_dataDevice" {
+  device_id         = wallix-bastion_device.testacc_dataDevice.id
+  service_name      = "testacc_dataDevice"
+  connection_policy = "SSH"
+  port              = 22
+  protocol          = "SSH"
+  subprotocols      = ["SSH_SHELL_SESSION"]
+}
+
+data "wallix-bastion_device" "testacc_dataDevice" {
+  device_name = "testacc_dataDevice"
+
+  depends_on = [wallix-bastion_device_service.testacc_dataDevice]
+}
+
+data "wallix-bastion_device" "testacc_dataDeviceByID" {
+  device_id = wallix-bastion_device.testacc_dataDevice.id
+}
+`
+}
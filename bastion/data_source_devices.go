@@ -0,0 +1,110 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDevices() *schema.Resource {
+	deviceSchema := dataSourceDevice().Schema
+	for _, k := range []string{"device_name", "device_id"} {
+		deviceSchema[k] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDevicesRead,
+		Schema: map[string]*schema.Schema{
+			"device_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"host": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"device_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"devices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: deviceSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDevicesVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_devices not available with api version %s", version)
+}
+
+func dataSourceDevicesRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceDevicesVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	filters := make(map[string]string)
+	for _, k := range []string{"device_name", "host", "alias"} {
+		if v := d.Get(k).(string); v != "" {
+			filters[k] = v
+		}
+	}
+	query := url.Values{"sort": []string{"device_name"}}
+	devices, err := searchResourcesAs[jsonDevice](ctx, "/devices/", filters, query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillDevices(d, devices)
+	d.SetId("devices?" + query.Encode())
+
+	return nil
+}
+
+func fillDevices(d *schema.ResourceData, devices []jsonDevice) {
+	deviceNames := make([]string, len(devices))
+	list := make([]map[string]interface{}, len(devices))
+	for i, v := range devices {
+		deviceNames[i] = v.DeviceName
+		list[i] = map[string]interface{}{
+			"device_id":     v.ID,
+			"device_name":   v.DeviceName,
+			"host":          v.Host,
+			"alias":         v.Alias,
+			"description":   v.Description,
+			"local_domains": flattenDeviceLocalDomains(v),
+			"services":      flattenDeviceServices(v),
+		}
+	}
+	if tfErr := d.Set("device_names", deviceNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("devices", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,54 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDevices_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDevicesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wallix-bastion_devices.testacc_dataDevices",
+						"device_names.#", "1"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_devices.testacc_dataDevices",
+						"devices.0.device_name", "testacc_dataDevices2"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_devices.testacc_dataDevices",
+						"devices.0.alias", "testacc_shared"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_devices.testacc_dataDevicesAlias",
+						"device_names.#", "2"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceDevicesConfig() string {
+	return `
+resource "wallix-bastion_device" "testacc_dataDevices" {
+  count = 2
+
+  device_name = "testacc_dataDevices${count.index + 1}"
+  host        = "testacc_data${count.index + 1}.devices"
+  alias       = "testacc_shared"
+}
+
+data "wallix-bastion_devices" "testacc_dataDevices" {
+  host = "testacc_data2.devices"
+
+  depends_on = [wallix-bastion_device.testacc_dataDevices]
+}
+
+data "wallix-bastion_devices" "testacc_dataDevicesAlias" {
+  alias = "testacc_shared"
+
+  depends_on = [wallix-bastion_device.testacc_dataDevices]
+}
+`
+}
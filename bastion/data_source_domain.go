@@ -66,18 +66,18 @@ func dataSourceDomainRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := dataSourceDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceDomain(ctx, d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s doesn't exists", d.Get("domain_name").(string)))
+		return diagFromErr(fmt.Errorf("domain_name %s doesn't exists", d.Get("domain_name").(string)))
 	}
 	cfg, err := readDomainOptions(ctx, id, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	fillSourceDomain(d, cfg)
 	d.SetId(id)
@@ -0,0 +1,63 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDomainAccount() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceDomainAccount().Schema)
+	for _, k := range []string{"domain_id", "account_name"} {
+		dataSchema[k] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDomainAccountRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceDomainAccountVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_domain_account not available with api version %s", version)
+}
+
+func dataSourceDomainAccountRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	domainID := d.Get("domain_id").(string)
+	id, ex, err := searchResourceDomainAccount(ctx, domainID, d.Get("account_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("account_name %s doesn't exists", d.Get("account_name").(string)))
+	}
+	cfg, err := readDomainAccountOptions(ctx, domainID, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("account with ID %s doesn't exists", id))
+	}
+	fillDomainAccount(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
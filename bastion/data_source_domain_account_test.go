@@ -0,0 +1,51 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDomainAccount_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDomainAccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_domain_account.testacc_dataDomainAccount", "id",
+						"wallix-bastion_domain_account.testacc_dataDomainAccount.1", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_domain_account.testacc_dataDomainAccount",
+						"account_login", "login2"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_domain_account.testacc_dataDomainAccount",
+						"checkout_policy", "default"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceDomainAccountConfig() string {
+	return `
+resource "wallix-bastion_domain" "testacc_dataDomainAccount" {
+  domain_name = "testacc_dataDomainAccount"
+}
+resource "wallix-bastion_domain_account" "testacc_dataDomainAccount" {
+  count = 2
+
+  domain_id     = wallix-bastion_domain.testacc_dataDomainAccount.id
+  account_name  = "testacc_dataDomainAccount${count.index + 1}"
+  account_login = "login${count.index + 1}"
+}
+
+data "wallix-bastion_domain_account" "testacc_dataDomainAccount" {
+  domain_id    = wallix-bastion_domain.testacc_dataDomainAccount.id
+  account_name = "testacc_dataDomainAccount2"
+
+  depends_on = [wallix-bastion_domain_account.testacc_dataDomainAccount]
+}
+`
+}
@@ -0,0 +1,90 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDomainAccounts() *schema.Resource {
+	dataSchema := map[string]*schema.Schema{
+		"account_names": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"accounts": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: dataSourceDomainAccountsElemSchema(),
+			},
+		},
+	}
+	for _, k := range []string{"domain_id"} {
+		dataSchema[k] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDomainAccountsRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceDomainAccountsElemSchema() map[string]*schema.Schema {
+	elemSchema := dataSourceSchemaFromResource(resourceDomainAccount().Schema, "domain_id")
+	elemSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return elemSchema
+}
+
+func dataSourceDomainAccountsVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_domain_accounts not available with api version %s", version)
+}
+
+func dataSourceDomainAccountsRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceDomainAccountsVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	path := "/domains/" + d.Get("domain_id").(string) + "/accounts/"
+	query := url.Values{"sort": []string{"account_name"}}
+	accounts, err := listResourcesAs[jsonDomainAccount](ctx, path, query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	elemSchema := dataSourceDomainAccountsElemSchema()
+	accountNames := make([]string, len(accounts))
+	list := make([]map[string]interface{}, len(accounts))
+	for i, v := range accounts {
+		accountNames[i] = v.AccountName
+		list[i] = flattenWithFill(elemSchema, fillDomainAccount, v.ID, v)
+	}
+	if tfErr := d.Set("account_names", accountNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("accounts", list); tfErr != nil {
+		panic(tfErr)
+	}
+	d.SetId(path + "?" + query.Encode())
+
+	return nil
+}
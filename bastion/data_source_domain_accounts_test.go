@@ -0,0 +1,49 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDomainAccounts_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDomainAccountsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wallix-bastion_domain_accounts.testacc_dataDomainAccounts",
+						"account_names.#", "2"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_domain_accounts.testacc_dataDomainAccounts",
+						"accounts.0.account_name", "testacc_dataDomainAccounts1"),
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_domain_accounts.testacc_dataDomainAccounts", "accounts.1.id",
+						"wallix-bastion_domain_account.testacc_dataDomainAccounts.1", "id"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceDomainAccountsConfig() string {
+	return `
+resource "wallix-bastion_domain" "testacc_dataDomainAccounts" {
+  domain_name = "testacc_dataDomainAccounts"
+}
+resource "wallix-bastion_domain_account" "testacc_dataDomainAccounts" {
+  count = 2
+
+  domain_id     = wallix-bastion_domain.testacc_dataDomainAccounts.id
+  account_name  = "testacc_dataDomainAccounts${count.index + 1}"
+  account_login = "login${count.index + 1}"
+}
+data "wallix-bastion_domain_accounts" "testacc_dataDomainAccounts" {
+  domain_id = wallix-bastion_domain.testacc_dataDomainAccounts.id
+
+  depends_on = [wallix-bastion_domain_account.testacc_dataDomainAccounts]
+}
+`
+}
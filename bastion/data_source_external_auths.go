@@ -0,0 +1,115 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// jsonExternalAuth is the part common to the external authentications of
+// all types.
+type jsonExternalAuth struct {
+	ID                 string `json:"id"`
+	AuthenticationName string `json:"authentication_name"`
+	Type               string `json:"type"`
+	Description        string `json:"description"`
+}
+
+func dataSourceExternalAuths() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceExternalAuthsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"KERBEROS", "KERBEROS-PASSWORD", "LDAP", "RADIUS", "SAML", "TACACS+",
+				}, false),
+			},
+			"authentication_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"external_auths": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authentication_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceExternalAuthsVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_external_auths not available with api version %s", version)
+}
+
+func dataSourceExternalAuthsRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceExternalAuthsVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	filters := make(map[string]string)
+	if v := d.Get("type").(string); v != "" {
+		filters["type"] = v
+	}
+	query := url.Values{"sort": []string{"authentication_name"}}
+	externalAuths, err := searchResourcesAs[jsonExternalAuth](ctx, "/externalauths/", filters, query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillExternalAuths(d, externalAuths)
+	d.SetId("externalauths?" + query.Encode())
+
+	return nil
+}
+
+func fillExternalAuths(d *schema.ResourceData, externalAuths []jsonExternalAuth) {
+	authenticationNames := make([]string, len(externalAuths))
+	list := make([]map[string]interface{}, len(externalAuths))
+	for i, v := range externalAuths {
+		authenticationNames[i] = v.AuthenticationName
+		list[i] = map[string]interface{}{
+			"id":                  v.ID,
+			"authentication_name": v.AuthenticationName,
+			"type":                v.Type,
+			"description":         v.Description,
+		}
+	}
+	if tfErr := d.Set("authentication_names", authenticationNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("external_auths", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
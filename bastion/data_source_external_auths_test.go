@@ -0,0 +1,64 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceExternalAuths_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceExternalAuthsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wallix-bastion_external_auths.testacc_dataExternalAuthsRadius",
+						"authentication_names.#", "1"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_external_auths.testacc_dataExternalAuthsRadius",
+						"external_auths.0.authentication_name", "testacc_dataExternalAuthsRadius"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_external_auths.testacc_dataExternalAuthsRadius",
+						"external_auths.0.type", "RADIUS"),
+					resource.TestCheckTypeSetElemAttr("data.wallix-bastion_external_auths.testacc_dataExternalAuths",
+						"authentication_names.*", "testacc_dataExternalAuthsTacacs"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceExternalAuthsConfig() string {
+	return `
+resource "wallix-bastion_externalauth_radius" "testacc_dataExternalAuthsRadius" {
+  authentication_name = "testacc_dataExternalAuthsRadius"
+  host                = "server1"
+  port                = 1813
+  secret              = "aSecret"
+  timeout             = 10
+}
+resource "wallix-bastion_externalauth_tacacs" "testacc_dataExternalAuthsTacacs" {
+  authentication_name = "testacc_dataExternalAuthsTacacs"
+  host                = "server1"
+  port                = 49
+  secret              = "aSecret"
+}
+
+data "wallix-bastion_external_auths" "testacc_dataExternalAuthsRadius" {
+  type = "RADIUS"
+
+  depends_on = [
+    wallix-bastion_externalauth_radius.testacc_dataExternalAuthsRadius,
+    wallix-bastion_externalauth_tacacs.testacc_dataExternalAuthsTacacs,
+  ]
+}
+
+data "wallix-bastion_external_auths" "testacc_dataExternalAuths" {
+  depends_on = [
+    wallix-bastion_externalauth_radius.testacc_dataExternalAuthsRadius,
+    wallix-bastion_externalauth_tacacs.testacc_dataExternalAuthsTacacs,
+  ]
+}
+`
+}
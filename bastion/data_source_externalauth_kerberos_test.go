@@ -0,0 +1,50 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceExternalAuthKerberos_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceExternalAuthKerberosConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_externalauth_kerberos.testacc_dataExternalAuthKerberos", "id",
+						"wallix-bastion_externalauth_kerberos.testacc_dataExternalAuthKerberos", "id"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_externalauth_kerberos.testacc_dataExternalAuthKerberos",
+						"ker_dom_controller", "EXAMPLE.COM"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_externalauth_kerberos.testacc_dataExternalAuthKerberos",
+						"kerberos_password", "true"),
+					resource.TestCheckNoResourceAttr(
+						"data.wallix-bastion_externalauth_kerberos.testacc_dataExternalAuthKerberos",
+						"keytab"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceExternalAuthKerberosConfig() string {
+	return `
+resource "wallix-bastion_externalauth_kerberos" "testacc_dataExternalAuthKerberos" {
+  authentication_name = "testacc_dataExternalAuthKerberos"
+  host                = "server1"
+  ker_dom_controller  = "EXAMPLE.COM"
+  port                = 88
+  kerberos_password   = true
+}
+
+data "wallix-bastion_externalauth_kerberos" "testacc_dataExternalAuthKerberos" {
+  authentication_name = wallix-bastion_externalauth_kerberos.testacc_dataExternalAuthKerberos.authentication_name
+}
+`
+}
@@ -0,0 +1,64 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceExternalAuthLdap() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceExternalAuthLdap().Schema, "authentication_name")
+	dataSchema["authentication_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceExternalAuthLdapRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceExternalAuthLdapVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_externalauth_ldap not available with api version %s", version)
+}
+
+func dataSourceExternalAuthLdapRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceExternalAuthLdapVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceExternalAuthLdap(ctx, d.Get("authentication_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("authentication_name %s doesn't exists", d.Get("authentication_name").(string)))
+	}
+	cfg, err := readExternalAuthLdapOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("external authentication with ID %s doesn't exists", id))
+	}
+	if cfg.Type != "LDAP" {
+		return diagFromErr(fmt.Errorf("authentication_name %s is a %s external authentication, not a LDAP one",
+			d.Get("authentication_name").(string), cfg.Type))
+	}
+	fillExternalAuthLdap(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
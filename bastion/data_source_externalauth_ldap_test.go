@@ -0,0 +1,52 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceExternalAuthLdap_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceExternalAuthLdapConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_externalauth_ldap.testacc_dataExternalAuthLDAP", "id",
+						"wallix-bastion_externalauth_ldap.testacc_dataExternalAuthLDAP", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_externalauth_ldap.testacc_dataExternalAuthLDAP",
+						"ldap_base", "OU=FR,DC=test,DC=com"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_externalauth_ldap.testacc_dataExternalAuthLDAP",
+						"login", "admin"),
+					resource.TestCheckNoResourceAttr("data.wallix-bastion_externalauth_ldap.testacc_dataExternalAuthLDAP",
+						"password"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceExternalAuthLdapConfig() string {
+	return `
+resource "wallix-bastion_externalauth_ldap" "testacc_dataExternalAuthLDAP" {
+  authentication_name = "testacc_dataExternalAuthLDAP"
+  cn_attribute        = "sAMAccountName"
+  host                = "server1"
+  ldap_base           = "OU=FR,DC=test,DC=com"
+  login_attribute     = "sAMAccountName"
+  port                = 636
+  timeout             = 10
+  is_ssl              = true
+  login               = "admin"
+  password            = "aPassword"
+}
+
+data "wallix-bastion_externalauth_ldap" "testacc_dataExternalAuthLDAP" {
+  authentication_name = wallix-bastion_externalauth_ldap.testacc_dataExternalAuthLDAP.authentication_name
+}
+`
+}
@@ -0,0 +1,64 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceExternalAuthRadius() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceExternalAuthRadius().Schema, "authentication_name")
+	dataSchema["authentication_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceExternalAuthRadiusRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceExternalAuthRadiusVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_externalauth_radius not available with api version %s", version)
+}
+
+func dataSourceExternalAuthRadiusRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceExternalAuthRadiusVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceExternalAuthRadius(ctx, d.Get("authentication_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("authentication_name %s doesn't exists", d.Get("authentication_name").(string)))
+	}
+	cfg, err := readExternalAuthRadiusOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("external authentication with ID %s doesn't exists", id))
+	}
+	if cfg.Type != "RADIUS" {
+		return diagFromErr(fmt.Errorf("authentication_name %s is a %s external authentication, not a RADIUS one",
+			d.Get("authentication_name").(string), cfg.Type))
+	}
+	fillExternalAuthRadius(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
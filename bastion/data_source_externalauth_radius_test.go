@@ -0,0 +1,45 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceExternalAuthRadius_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceExternalAuthRadiusConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_externalauth_radius.testacc_dataExternalAuthRadius", "id",
+						"wallix-bastion_externalauth_radius.testacc_dataExternalAuthRadius", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_externalauth_radius.testacc_dataExternalAuthRadius",
+						"port", "1813"),
+					resource.TestCheckNoResourceAttr("data.wallix-bastion_externalauth_radius.testacc_dataExternalAuthRadius",
+						"secret"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceExternalAuthRadiusConfig() string {
+	return `
+resource "wallix-bastion_externalauth_radius" "testacc_dataExternalAuthRadius" {
+  authentication_name = "testacc_dataExternalAuthRadius"
+  host                = "server1"
+  port                = 1813
+  secret              = "aSecret"
+  timeout             = 10
+}
+
+data "wallix-bastion_externalauth_radius" "testacc_dataExternalAuthRadius" {
+  authentication_name = wallix-bastion_externalauth_radius.testacc_dataExternalAuthRadius.authentication_name
+}
+`
+}
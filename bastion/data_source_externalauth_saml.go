@@ -0,0 +1,66 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceExternalAuthSaml() *schema.Resource {
+	// claim_customization isn't returned by the bastion
+	dataSchema := dataSourceSchemaFromResource(resourceExternalAuthSaml().Schema,
+		"authentication_name", "claim_customization")
+	dataSchema["authentication_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceExternalAuthSamlRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceExternalAuthSamlVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_externalauth_saml not available with api version %s", version)
+}
+
+func dataSourceExternalAuthSamlRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceExternalAuthSamlVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceExternalAuthSaml(ctx, d.Get("authentication_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("authentication_name %s doesn't exists", d.Get("authentication_name").(string)))
+	}
+	cfg, err := readExternalAuthSamlOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("external authentication with ID %s doesn't exists", id))
+	}
+	if cfg.Type != "SAML" {
+		return diagFromErr(fmt.Errorf("authentication_name %s is a %s external authentication, not a SAML one",
+			d.Get("authentication_name").(string), cfg.Type))
+	}
+	fillExternalAuthSaml(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package bastion_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceExternalAuthSaml_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceExternalAuthSamlConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_externalauth_saml.testacc_dataExternalAuthSaml", "id",
+						"wallix-bastion_externalauth_saml.testacc_dataExternalAuthSaml", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_externalauth_saml.testacc_dataExternalAuthSaml",
+						"timeout", "30"),
+					resource.TestCheckNoResourceAttr("data.wallix-bastion_externalauth_saml.testacc_dataExternalAuthSaml",
+						"private_key"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceExternalAuthSamlConfig() string {
+	return fmt.Sprintf(`
+resource "wallix-bastion_externalauth_saml" "testacc_dataExternalAuthSaml" {
+  authentication_name = "testacc_dataExternalAuthSaml"
+  idp_metadata        = <<EOT
+%s
+EOT
+  timeout             = 30
+}
+
+data "wallix-bastion_externalauth_saml" "testacc_dataExternalAuthSaml" {
+  authentication_name = wallix-bastion_externalauth_saml.testacc_dataExternalAuthSaml.authentication_name
+}
+`, idpMetadataSAML)
+}
@@ -0,0 +1,64 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceExternalAuthTacacs() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceExternalAuthTacacs().Schema, "authentication_name")
+	dataSchema["authentication_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceExternalAuthTacacsRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceExternalAuthTacacsVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_externalauth_tacacs not available with api version %s", version)
+}
+
+func dataSourceExternalAuthTacacsRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceExternalAuthTacacsVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceExternalAuthTacacs(ctx, d.Get("authentication_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("authentication_name %s doesn't exists", d.Get("authentication_name").(string)))
+	}
+	cfg, err := readExternalAuthTacacsOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("external authentication with ID %s doesn't exists", id))
+	}
+	if cfg.Type != "TACACS+" {
+		return diagFromErr(fmt.Errorf("authentication_name %s is a %s external authentication, not a TACACS+ one",
+			d.Get("authentication_name").(string), cfg.Type))
+	}
+	fillExternalAuthTacacs(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
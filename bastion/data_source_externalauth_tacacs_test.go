@@ -0,0 +1,53 @@
+package bastion_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceExternalAuthTacacs_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceExternalAuthTacacsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_externalauth_tacacs.testacc_dataExternalAuthTacacs", "id",
+						"wallix-bastion_externalauth_tacacs.testacc_dataExternalAuthTacacs", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_externalauth_tacacs.testacc_dataExternalAuthTacacs",
+						"port", "49"),
+					resource.TestCheckNoResourceAttr("data.wallix-bastion_externalauth_tacacs.testacc_dataExternalAuthTacacs",
+						"secret"),
+				),
+			},
+			{
+				Config: testAccDataSourceExternalAuthTacacsConfig() + `
+data "wallix-bastion_externalauth_radius" "testacc_dataExternalAuthTacacs" {
+  authentication_name = wallix-bastion_externalauth_tacacs.testacc_dataExternalAuthTacacs.authentication_name
+}
+`,
+				ExpectError: regexp.MustCompile(`is a TACACS\+ external authentication, not a RADIUS one`),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceExternalAuthTacacsConfig() string {
+	return `
+resource "wallix-bastion_externalauth_tacacs" "testacc_dataExternalAuthTacacs" {
+  authentication_name = "testacc_dataExternalAuthTacacs"
+  host                = "server1"
+  port                = 49
+  secret              = "aSecret"
+}
+
+data "wallix-bastion_externalauth_tacacs" "testacc_dataExternalAuthTacacs" {
+  authentication_name = wallix-bastion_externalauth_tacacs.testacc_dataExternalAuthTacacs.authentication_name
+}
+`
+}
@@ -2,33 +2,13 @@ package bastion
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"slices"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-type jsonLocalPasswordPolicy struct {
-	AllowSameUserAndPassword bool     `json:"allow_same_user_and_password"`
-	ID                       string   `json:"id,omitempty"`
-	PasswordPolicyName       string   `json:"password_policy_name"`
-	PasswordExpiration       int      `json:"password_expiration"`
-	PasswordWarningDays      int      `json:"password_warning_days"`
-	PasswordMinLength        int      `json:"password_min_length"`
-	PasswordMinLowerChars    int      `json:"password_min_lower_chars"`
-	PasswordMinUpperChars    int      `json:"password_min_upper_chars"`
-	PasswordMinDigitChars    int      `json:"password_min_digit_chars"`
-	PasswordMinSpecialChars  int      `json:"password_min_special_chars"`
-	LastPasswordsToReject    int      `json:"last_passwords_to_reject"`
-	MaxAuthFailures          int      `json:"max_auth_failures"`
-	SSHRsaMinLength          int      `json:"ssh_rsa_min_length"`
-	ForbiddenPasswords       []string `json:"forbidden_passwords"`
-	SSHKeyAlgosAllowed       []string `json:"ssh_key_algos_allowed"`
-}
-
 func dataSourceLocalPasswordPolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceLocalPasswordPolicyRead,
@@ -109,82 +89,24 @@ func dataSourceLocalPasswordPolicyRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := dataSourceLocalPasswordPolicyVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
-	cfg, err := readLocalPasswordPolicyOptions(ctx, d.Get("password_policy_name").(string), m)
+	id, ex, err := searchResourceLocalPasswordPolicy(ctx, d.Get("password_policy_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
-	fillLocalPasswordPolicy(d, cfg)
-	d.SetId(cfg.ID)
-
-	return nil
-}
-
-func readLocalPasswordPolicyOptions(
-	ctx context.Context, passwordPolicyName string, m interface{},
-) (
-	jsonLocalPasswordPolicy, error,
-) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx,
-		"/localpasswordpolicies/?q=password_policy_name="+passwordPolicyName, http.MethodGet, nil)
-	if err != nil {
-		return jsonLocalPasswordPolicy{}, err
+	if !ex {
+		return diagFromErr(fmt.Errorf("password_policy_name %s not found", d.Get("password_policy_name").(string)))
 	}
-	if code != http.StatusOK {
-		return jsonLocalPasswordPolicy{}, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonLocalPasswordPolicy
-	err = json.Unmarshal([]byte(body), &results)
+	cfg, err := readLocalPasswordPolicyOptions(ctx, id, m)
 	if err != nil {
-		return jsonLocalPasswordPolicy{}, fmt.Errorf("unmarshaling json: %w", err)
+		return diagFromErr(err)
 	}
-	if len(results) == 0 {
-		return jsonLocalPasswordPolicy{}, fmt.Errorf("password_policy_name %s not found", passwordPolicyName)
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("password_policy_name %s not found", d.Get("password_policy_name").(string)))
 	}
+	fillLocalPasswordPolicy(d, cfg)
+	d.SetId(cfg.ID)
 
-	return results[0], nil
-}
-
-func fillLocalPasswordPolicy(d *schema.ResourceData, jsonData jsonLocalPasswordPolicy) {
-	if tfErr := d.Set("allow_same_user_and_password", jsonData.AllowSameUserAndPassword); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("password_expiration", jsonData.PasswordExpiration); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("password_warning_days", jsonData.PasswordWarningDays); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("password_min_length", jsonData.PasswordMinLength); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("password_min_lower_chars", jsonData.PasswordMinLowerChars); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("password_min_upper_chars", jsonData.PasswordMinUpperChars); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("password_min_digit_chars", jsonData.PasswordMinDigitChars); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("password_min_special_chars", jsonData.PasswordMinSpecialChars); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("last_passwords_to_reject", jsonData.LastPasswordsToReject); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("max_auth_failures", jsonData.MaxAuthFailures); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("ssh_rsa_min_length", jsonData.SSHRsaMinLength); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("forbidden_passwords", jsonData.ForbiddenPasswords); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("ssh_key_algos_allowed", jsonData.SSHKeyAlgosAllowed); tfErr != nil {
-		panic(tfErr)
-	}
+	return nil
 }
@@ -0,0 +1,61 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourcePasswordChangePolicy() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourcePasswordChangePolicy().Schema, "password_change_policy_name")
+	dataSchema["password_change_policy_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePasswordChangePolicyRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourcePasswordChangePolicyVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_password_change_policy not available with api version %s", version)
+}
+
+func dataSourcePasswordChangePolicyRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourcePasswordChangePolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourcePasswordChangePolicy(ctx, d.Get("password_change_policy_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("password_change_policy_name %s doesn't exists",
+			d.Get("password_change_policy_name").(string)))
+	}
+	cfg, err := readPasswordChangePolicyOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("password change policy with ID %s doesn't exists", id))
+	}
+	fillPasswordChangePolicy(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePasswordChangePolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePasswordChangePolicyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_password_change_policy.testacc_dataPasswordChangePolicy", "id",
+						"wallix-bastion_password_change_policy.testacc_dataPasswordChangePolicy", "id"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_password_change_policy.testacc_dataPasswordChangePolicy",
+						"ssh_key_type", "ECDSA"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_password_change_policy.testacc_dataPasswordChangePolicy",
+						"change_period", "0 3 1 * *"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourcePasswordChangePolicyConfig() string {
+	return `
+resource "wallix-bastion_password_change_policy" "testacc_dataPasswordChangePolicy" {
+  password_change_policy_name = "testacc_dataPasswordChangePolicy"
+  ssh_key_type                = "ECDSA"
+  ssh_key_size                = 384
+  change_period               = "0 3 1 * *"
+}
+
+data "wallix-bastion_password_change_policy" "testacc_dataPasswordChangePolicy" {
+  password_change_policy_name = wallix-bastion_password_change_policy.testacc_dataPasswordChangePolicy.password_change_policy_name
+}
+`
+}
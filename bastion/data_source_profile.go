@@ -0,0 +1,60 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceProfile() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceProfile().Schema, "profile_name")
+	dataSchema["profile_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceProfileRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceProfileVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_profile not available with api version %s", version)
+}
+
+func dataSourceProfileRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceProfileVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceProfile(ctx, d.Get("profile_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("profile_name %s doesn't exists", d.Get("profile_name").(string)))
+	}
+	cfg, err := readProfileOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("profile with ID %s doesn't exists", id))
+	}
+	fillProfile(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceProfile_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProfileConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_profile.testacc_dataProfile", "id",
+						"wallix-bastion_profile.testacc_dataProfile", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_profile.testacc_dataProfile",
+						"gui_features.0.users", "view"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_profile.testacc_dataProfile",
+						"gui_transmission.0.devices", "view"),
+					resource.TestCheckResourceAttrSet("data.wallix-bastion_profile.testacc_dataProfileBuiltin", "id"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceProfileConfig() string {
+	return `
+resource "wallix-bastion_profile" "testacc_dataProfile" {
+  profile_name = "testacc_dataProfile"
+  gui_features {
+    users   = "view"
+    devices = "view"
+  }
+  gui_transmission {
+    users   = "view"
+    devices = "view"
+  }
+}
+
+data "wallix-bastion_profile" "testacc_dataProfile" {
+  profile_name = wallix-bastion_profile.testacc_dataProfile.profile_name
+}
+
+data "wallix-bastion_profile" "testacc_dataProfileBuiltin" {
+  profile_name = "user"
+}
+`
+}
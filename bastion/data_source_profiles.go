@@ -0,0 +1,92 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceProfiles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProfilesRead,
+		Schema: map[string]*schema.Schema{
+			"profile_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"profiles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceProfilesElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProfilesElemSchema() map[string]*schema.Schema {
+	elemSchema := dataSourceSchemaFromResource(resourceProfile().Schema)
+	elemSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return elemSchema
+}
+
+func dataSourceProfilesVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_profiles not available with api version %s", version)
+}
+
+func dataSourceProfilesRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceProfilesVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	query := url.Values{"sort": []string{"profile_name"}}
+	profiles, err := listResourcesAs[jsonProfile](ctx, "/profiles/", query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillProfiles(d, profiles)
+	d.SetId("profiles?" + query.Encode())
+
+	return nil
+}
+
+func fillProfiles(d *schema.ResourceData, profiles []jsonProfile) {
+	elemSchema := dataSourceProfilesElemSchema()
+	profileNames := make([]string, len(profiles))
+	list := make([]map[string]interface{}, len(profiles))
+	for i, v := range profiles {
+		profileNames[i] = v.ProfileName
+		list[i] = flattenWithFill(elemSchema, fillProfileWithName, v.ID, v)
+	}
+	if tfErr := d.Set("profile_names", profileNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("profiles", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
+
+// fillProfileWithName also sets profile_name, which fillProfile leaves to the
+// configuration of the resource.
+func fillProfileWithName(d *schema.ResourceData, jsonData jsonProfile) {
+	fillProfile(d, jsonData)
+	if tfErr := d.Set("profile_name", jsonData.ProfileName); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,50 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceProfiles_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProfilesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.wallix-bastion_profiles.testacc_dataProfiles",
+						"profile_names.*", "user"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.wallix-bastion_profiles.testacc_dataProfiles", "profiles.*",
+						map[string]string{
+							"profile_name":           "testacc_dataProfiles",
+							"description":            "testacc dataProfiles",
+							"gui_features.0.devices": "modify",
+						}),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceProfilesConfig() string {
+	return `
+resource "wallix-bastion_profile" "testacc_dataProfiles" {
+  profile_name = "testacc_dataProfiles"
+  description  = "testacc dataProfiles"
+  gui_features {
+    devices = "modify"
+  }
+  gui_transmission {
+    devices = "modify"
+  }
+}
+
+data "wallix-bastion_profiles" "testacc_dataProfiles" {
+  depends_on = [wallix-bastion_profile.testacc_dataProfiles]
+}
+`
+}
@@ -0,0 +1,66 @@
+package bastion
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSchemaFromResource returns the attributes of a resource as
+// computed attributes of a data source, without the omitted ones, without
+// the sensitive ones, as data sources never expose secrets, and without the
+// write-only ones with their _version trigger, as the bastion never reads
+// them back.
+func dataSourceSchemaFromResource(
+	resourceSchema map[string]*schema.Schema, omit ...string,
+) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(resourceSchema))
+	for k, v := range resourceSchema {
+		if v.Sensitive || v.WriteOnly || slices.Contains(omit, k) {
+			continue
+		}
+		if writeOnly, ok := resourceSchema[strings.TrimSuffix(k, "_version")]; ok && writeOnly.WriteOnly {
+			continue
+		}
+		result[k] = dataSourceSchemaComputed(v)
+	}
+
+	return result
+}
+
+func dataSourceSchemaComputed(s *schema.Schema) *schema.Schema {
+	result := &schema.Schema{
+		Type:     s.Type,
+		Computed: true,
+	}
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		result.Elem = &schema.Resource{Schema: dataSourceSchemaFromResource(elem.Schema)}
+	case *schema.Schema:
+		result.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return result
+}
+
+// flattenWithFill returns the attributes that fill sets from jsonData, as an
+// element of a list in a plural data source, with id as its id attribute.
+// It allows plural data sources to reuse the fill function of the resource.
+func flattenWithFill[T any](
+	elemSchema map[string]*schema.Schema, fill func(*schema.ResourceData, T), id string, jsonData T,
+) map[string]interface{} {
+	d := (&schema.Resource{Schema: elemSchema}).Data(nil)
+	fill(d, jsonData)
+	result := make(map[string]interface{}, len(elemSchema))
+	for k := range elemSchema {
+		v := d.Get(k)
+		if set, ok := v.(*schema.Set); ok {
+			v = set.List()
+		}
+		result[k] = v
+	}
+	result["id"] = id
+
+	return result
+}
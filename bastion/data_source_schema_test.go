@@ -0,0 +1,26 @@
+package bastion
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDataSourceSchemaFromResourceWriteOnly(t *testing.T) {
+	provider := Provider()
+	for _, name := range []string{
+		"wallix-bastion_authdomain_azuread",
+		"wallix-bastion_externalauth_radius",
+		"wallix-bastion_externalauth_tacacs",
+	} {
+		if _, ok := provider.DataSourcesMap[name]; !ok {
+			t.Errorf("data source %s not found", name)
+		}
+	}
+	for name, dataSource := range provider.DataSourcesMap {
+		for k := range dataSource.Schema {
+			if strings.HasSuffix(k, "_wo") || strings.HasSuffix(k, "_wo_version") {
+				t.Errorf("data source %s has the write-only attribute %s", name, k)
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTargetgroup_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTargetgroupConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_targetgroup.testacc_dataTargetgroup", "id",
+						"wallix-bastion_targetgroup.testacc_dataTargetgroup", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_targetgroup.testacc_dataTargetgroup",
+						"description", "testacc dataTargetgroup"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_targetgroup.testacc_dataTargetgroup",
+						"password_retrieval_accounts.#", "1"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_targetgroup.testacc_dataTargetgroup",
+						"session_accounts.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.wallix-bastion_targetgroup.testacc_dataTargetgroup", "session_accounts.*",
+						map[string]string{
+							"account": "testacc_dataTargetgroup_admin",
+							"device":  "testacc_dataTargetgroup",
+							"service": "testacc_dataTargetgroup",
+						}),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceTargetgroupConfig() string {
+	return `
+resource "wallix-bastion_targetgroup" "testacc_dataTargetgroup" {
+  group_name  = "testacc_dataTargetgroup"
+  description = "testacc dataTargetgroup"
+  password_retrieval_accounts {
+    account     = wallix-bastion_domain_account.testacc_dataTargetgroup.account_name
+    domain      = wallix-bastion_domain.testacc_dataTargetgroup.domain_name
+    domain_type = "global"
+  }
+  session_accounts {
+    account     = wallix-bastion_domain_account.testacc_dataTargetgroup.account_name
+    domain      = wallix-bastion_domain.testacc_dataTargetgroup.domain_name
+    domain_type = "global"
+    device      = wallix-bastion_device.testacc_dataTargetgroup.device_name
+    service     = wallix-bastion_device_service.testacc_dataTargetgroup.service_name
+  }
+}
+resource "wallix-bastion_device" "testacc_dataTargetgroup" {
+  device_name = "testacc_dataTargetgroup"
+  host        = "testacc_dataTargetgroup.device"
+}
+resource "wallix-bastion_device_service" "testacc_dataTargetgroup" {
+  device_id         = wallix-bastion_device.testacc_dataTargetgroup.id
+  service_name      = "testacc_dataTargetgroup"
+  connection_policy = "SSH"
+  port              = 22
+  protocol          = "SSH"
+  subprotocols      = ["SSH_SHELL_SESSION"]
+  global_domains    = [wallix-bastion_domain.testacc_dataTargetgroup.domain_name]
+}
+resource "wallix-bastion_domain" "testacc_dataTargetgroup" {
+  domain_name = "testacc_dataTargetgroup"
+}
+resource "wallix-bastion_domain_account" "testacc_dataTargetgroup" {
+  domain_id     = wallix-bastion_domain.testacc_dataTargetgroup.id
+  account_name  = "testacc_dataTargetgroup_admin"
+  account_login = "admin"
+}
+
+data "wallix-bastion_targetgroup" "testacc_dataTargetgroup" {
+  group_name = wallix-bastion_targetgroup.testacc_dataTargetgroup.group_name
+}
+`
+}
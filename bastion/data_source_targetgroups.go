@@ -0,0 +1,83 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTargetGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTargetGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"group_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"target_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceTargetGroupsElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTargetGroupsElemSchema() map[string]*schema.Schema {
+	elemSchema := dataSourceSchemaFromResource(resourceTargetGroup().Schema)
+	elemSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return elemSchema
+}
+
+func dataSourceTargetGroupsVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_targetgroups not available with api version %s", version)
+}
+
+func dataSourceTargetGroupsRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceTargetGroupsVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	query := url.Values{"sort": []string{"group_name"}}
+	targetGroups, err := listResourcesAs[jsonTargetGroup](ctx, "/targetgroups/", query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillTargetGroups(d, targetGroups)
+	d.SetId("targetgroups?" + query.Encode())
+
+	return nil
+}
+
+func fillTargetGroups(d *schema.ResourceData, targetGroups []jsonTargetGroup) {
+	elemSchema := dataSourceTargetGroupsElemSchema()
+	groupNames := make([]string, len(targetGroups))
+	list := make([]map[string]interface{}, len(targetGroups))
+	for i, v := range targetGroups {
+		groupNames[i] = v.GroupName
+		list[i] = flattenWithFill(elemSchema, fillTargetGroup, v.ID, v)
+	}
+	if tfErr := d.Set("group_names", groupNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("target_groups", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,51 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTargetgroups_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTargetgroupsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.wallix-bastion_targetgroups.testacc_dataTargetgroups",
+						"group_names.*", "testacc_dataTargetgroups1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.wallix-bastion_targetgroups.testacc_dataTargetgroups", "target_groups.*",
+						map[string]string{
+							"group_name":     "testacc_dataTargetgroups2",
+							"description":    "testacc dataTargetgroups2",
+							"restrictions.#": "1",
+						}),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceTargetgroupsConfig() string {
+	return `
+resource "wallix-bastion_targetgroup" "testacc_dataTargetgroups" {
+  count = 2
+
+  group_name  = "testacc_dataTargetgroups${count.index + 1}"
+  description = "testacc dataTargetgroups${count.index + 1}"
+  restrictions {
+    action      = "notify"
+    rules       = "command"
+    subprotocol = "SSH_REMOTE_COMMAND"
+  }
+}
+
+data "wallix-bastion_targetgroups" "testacc_dataTargetgroups" {
+  depends_on = [wallix-bastion_targetgroup.testacc_dataTargetgroups]
+}
+`
+}
@@ -0,0 +1,53 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTimeframe() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceTimeframe().Schema, "timeframe_name")
+	dataSchema["timeframe_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceTimeframeRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceTimeframeVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_timeframe not available with api version %s", version)
+}
+
+func dataSourceTimeframeRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceTimeframeVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	cfg, err := readTimeframeOptions(ctx, d.Get("timeframe_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.TimeframeName == "" {
+		return diagFromErr(fmt.Errorf("timeframe_name %s doesn't exists", d.Get("timeframe_name").(string)))
+	}
+	fillTimeframe(d, cfg)
+	d.SetId(cfg.TimeframeName)
+
+	return nil
+}
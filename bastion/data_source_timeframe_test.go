@@ -0,0 +1,51 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTimeframe_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTimeframeConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wallix-bastion_timeframe.testacc_dataTimeframe",
+						"id", "testacc_dataTimeframe"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_timeframe.testacc_dataTimeframe",
+						"periods.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.wallix-bastion_timeframe.testacc_dataTimeframe", "periods.*",
+						map[string]string{
+							"start_time": "08:00",
+							"end_time":   "12:00",
+						}),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceTimeframeConfig() string {
+	return `
+resource "wallix-bastion_timeframe" "testacc_dataTimeframe" {
+  timeframe_name = "testacc_dataTimeframe"
+  periods {
+    start_date = "2020-01-01"
+    end_date   = "2020-02-02"
+    start_time = "08:00"
+    end_time   = "12:00"
+    week_days  = ["monday"]
+  }
+}
+
+data "wallix-bastion_timeframe" "testacc_dataTimeframe" {
+  timeframe_name = wallix-bastion_timeframe.testacc_dataTimeframe.timeframe_name
+}
+`
+}
@@ -0,0 +1,83 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTimeframes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTimeframesRead,
+		Schema: map[string]*schema.Schema{
+			"timeframe_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"timeframes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceTimeframesElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTimeframesElemSchema() map[string]*schema.Schema {
+	elemSchema := dataSourceSchemaFromResource(resourceTimeframe().Schema)
+	elemSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return elemSchema
+}
+
+func dataSourceTimeframesVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_timeframes not available with api version %s", version)
+}
+
+func dataSourceTimeframesRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceTimeframesVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	query := url.Values{"sort": []string{"timeframe_name"}}
+	timeframes, err := listResourcesAs[jsonTimeframe](ctx, "/timeframes/", query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillTimeframes(d, timeframes)
+	d.SetId("timeframes?" + query.Encode())
+
+	return nil
+}
+
+func fillTimeframes(d *schema.ResourceData, timeframes []jsonTimeframe) {
+	elemSchema := dataSourceTimeframesElemSchema()
+	timeframeNames := make([]string, len(timeframes))
+	list := make([]map[string]interface{}, len(timeframes))
+	for i, v := range timeframes {
+		timeframeNames[i] = v.TimeframeName
+		list[i] = flattenWithFill(elemSchema, fillTimeframe, v.TimeframeName, v)
+	}
+	if tfErr := d.Set("timeframe_names", timeframeNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("timeframes", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
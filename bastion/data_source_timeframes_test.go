@@ -0,0 +1,43 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTimeframes_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTimeframesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.wallix-bastion_timeframes.testacc_dataTimeframes",
+						"timeframe_names.*", "allthetime"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.wallix-bastion_timeframes.testacc_dataTimeframes", "timeframes.*",
+						map[string]string{
+							"id":             "testacc_dataTimeframes",
+							"is_overtimable": "true",
+						}),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceTimeframesConfig() string {
+	return `
+resource "wallix-bastion_timeframe" "testacc_dataTimeframes" {
+  timeframe_name = "testacc_dataTimeframes"
+  is_overtimable = true
+}
+
+data "wallix-bastion_timeframes" "testacc_dataTimeframes" {
+  depends_on = [wallix-bastion_timeframe.testacc_dataTimeframes]
+}
+`
+}
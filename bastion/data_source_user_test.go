@@ -0,0 +1,55 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceUser_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUserConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wallix-bastion_user.testacc_dataUser",
+						"id", "testacc_dataUser"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_user.testacc_dataUser",
+						"email", "testacc-datauser@none.none"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_user.testacc_dataUser",
+						"profile", "user"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_user.testacc_dataUser",
+						"groups.#", "1"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_user.testacc_dataUser",
+						"user_auths.#", "1"),
+					resource.TestCheckNoResourceAttr("data.wallix-bastion_user.testacc_dataUser",
+						"password"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceUserConfig() string {
+	return `
+resource "wallix-bastion_usergroup" "testacc_dataUser" {
+  group_name = "testacc_dataUser"
+  timeframes = ["allthetime"]
+}
+
+resource "wallix-bastion_user" "testacc_dataUser" {
+  user_name  = "testacc_dataUser"
+  email      = "testacc-datauser@none.none"
+  profile    = "user"
+  user_auths = ["local_password"]
+  groups     = [wallix-bastion_usergroup.testacc_dataUser.group_name]
+}
+
+data "wallix-bastion_user" "testacc_dataUser" {
+  user_name = wallix-bastion_user.testacc_dataUser.user_name
+}
+`
+}
@@ -0,0 +1,60 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceUserGroup() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceUserGroup().Schema, "group_name")
+	dataSchema["group_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceUserGroupRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceUserGroupVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_usergroup not available with api version %s", version)
+}
+
+func dataSourceUserGroupRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceUserGroupVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourceUserGroup(ctx, d.Get("group_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("group_name %s doesn't exists", d.Get("group_name").(string)))
+	}
+	cfg, err := readUserGroupOptions(ctx, id, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("usergroup with ID %s doesn't exists", id))
+	}
+	fillUserGroup(d, cfg)
+	d.SetId(cfg.ID)
+
+	return nil
+}
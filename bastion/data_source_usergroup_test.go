@@ -0,0 +1,50 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceUsergroup_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUsergroupConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_usergroup.testacc_dataUsergroup", "id",
+						"wallix-bastion_usergroup.testacc_dataUsergroup", "id"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_usergroup.testacc_dataUsergroup",
+						"description", "testacc dataUsergroup"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_usergroup.testacc_dataUsergroup",
+						"timeframes.#", "1"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_usergroup.testacc_dataUsergroup",
+						"restrictions.#", "1"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceUsergroupConfig() string {
+	return `
+resource "wallix-bastion_usergroup" "testacc_dataUsergroup" {
+  group_name  = "testacc_dataUsergroup"
+  description = "testacc dataUsergroup"
+  timeframes  = ["allthetime"]
+  restrictions {
+    action      = "kill"
+    rules       = "command"
+    subprotocol = "SSH_SHELL_SESSION"
+  }
+}
+
+data "wallix-bastion_usergroup" "testacc_dataUsergroup" {
+  group_name = wallix-bastion_usergroup.testacc_dataUsergroup.group_name
+}
+`
+}
@@ -0,0 +1,93 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceUserGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"group_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceUserGroupsElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserGroupsElemSchema() map[string]*schema.Schema {
+	elemSchema := dataSourceSchemaFromResource(resourceUserGroup().Schema)
+	elemSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return elemSchema
+}
+
+func dataSourceUserGroupsVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_usergroups not available with api version %s", version)
+}
+
+func dataSourceUserGroupsRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceUserGroupsVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	filters := make(map[string]string)
+	if v := d.Get("profile").(string); v != "" {
+		filters["profile"] = v
+	}
+	query := url.Values{"sort": []string{"group_name"}}
+	userGroups, err := searchResourcesAs[jsonUserGroup](ctx, "/usergroups/", filters, query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillUserGroups(d, userGroups)
+	d.SetId("usergroups?" + query.Encode())
+
+	return nil
+}
+
+func fillUserGroups(d *schema.ResourceData, userGroups []jsonUserGroup) {
+	elemSchema := dataSourceUserGroupsElemSchema()
+	groupNames := make([]string, len(userGroups))
+	list := make([]map[string]interface{}, len(userGroups))
+	for i, v := range userGroups {
+		groupNames[i] = v.GroupName
+		list[i] = flattenWithFill(elemSchema, fillUserGroup, v.ID, v)
+	}
+	if tfErr := d.Set("group_names", groupNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("user_groups", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,53 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceUsergroups_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUsergroupsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wallix-bastion_usergroups.testacc_dataUsergroups",
+						"group_names.#", "1"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_usergroups.testacc_dataUsergroups",
+						"user_groups.0.group_name", "testacc_dataUsergroups2"),
+					resource.TestCheckResourceAttrPair(
+						"data.wallix-bastion_usergroups.testacc_dataUsergroups", "user_groups.0.id",
+						"wallix-bastion_usergroup.testacc_dataUsergroups2", "id"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceUsergroupsConfig() string {
+	return `
+resource "wallix-bastion_usergroup" "testacc_dataUsergroups1" {
+  group_name = "testacc_dataUsergroups1"
+  timeframes = ["allthetime"]
+  profile    = "user"
+}
+resource "wallix-bastion_usergroup" "testacc_dataUsergroups2" {
+  group_name = "testacc_dataUsergroups2"
+  timeframes = ["allthetime"]
+  profile    = "approver"
+}
+
+data "wallix-bastion_usergroups" "testacc_dataUsergroups" {
+  profile = "approver"
+
+  depends_on = [
+    wallix-bastion_usergroup.testacc_dataUsergroups1,
+    wallix-bastion_usergroup.testacc_dataUsergroups2,
+  ]
+}
+`
+}
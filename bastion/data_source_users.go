@@ -0,0 +1,141 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceUsers() *schema.Resource {
+	userSchema := dataSourceUser().Schema
+	userSchema["user_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"user_auth": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"sort": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "user_name",
+				ValidateFunc: validation.StringInSlice([]string{
+					"user_name", "display_name", "email", "profile", "expiration_date", "last_connection",
+				}, false),
+			},
+			"sort_descending": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"user_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: userSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("data source wallix-bastion_users not available with api version %s", version)
+}
+
+func dataSourceUsersRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := dataSourceUsersVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	filters := make(map[string]string)
+	if v := d.Get("profile").(string); v != "" {
+		filters["profile"] = v
+	}
+	if v := d.Get("group").(string); v != "" {
+		filters["groups"] = v
+	}
+	if v := d.Get("user_auth").(string); v != "" {
+		filters["user_auths"] = v
+	}
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr("is_disabled").IsNull() {
+		filters["is_disabled"] = strconv.FormatBool(d.Get("is_disabled").(bool))
+	}
+	sort := d.Get("sort").(string)
+	if d.Get("sort_descending").(bool) {
+		sort = "-" + sort
+	}
+	query := url.Values{"sort": []string{sort}}
+	users, err := searchResourcesAs[jsonUser](ctx, "/users/", filters, query, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	fillUsers(d, users)
+	d.SetId("users?" + query.Encode())
+
+	return nil
+}
+
+func fillUsers(d *schema.ResourceData, users []jsonUser) {
+	userNames := make([]string, len(users))
+	list := make([]map[string]interface{}, len(users))
+	for i, v := range users {
+		userNames[i] = v.UserName
+		list[i] = map[string]interface{}{
+			"user_name":          v.UserName,
+			"email":              v.Email,
+			"profile":            v.Profile,
+			"user_auths":         v.UserAuths,
+			"certificate_dn":     v.CertificateCN,
+			"display_name":       v.DisplayName,
+			"expiration_date":    v.ExpirationDate,
+			"ip_source":          v.IPSource,
+			"is_disabled":        v.IsDisabled,
+			"preferred_language": v.PreferredLanguage,
+			"ssh_public_key":     v.SSHPublicKey,
+			"groups":             v.Groups,
+		}
+	}
+	if tfErr := d.Set("user_names", userNames); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("users", list); tfErr != nil {
+		panic(tfErr)
+	}
+}
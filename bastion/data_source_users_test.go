@@ -0,0 +1,67 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceUsers_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUsersConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wallix-bastion_users.testacc_dataUsers",
+						"user_names.#", "2"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_users.testacc_dataUsers",
+						"user_names.0", "testacc_dataUsers2"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_users.testacc_dataUsers",
+						"users.1.email", "testacc-datausers1@none.none"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_users.testacc_dataUsersDisabled",
+						"user_names.#", "1"),
+					resource.TestCheckResourceAttr("data.wallix-bastion_users.testacc_dataUsersDisabled",
+						"users.0.user_name", "testacc_dataUsers3"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccDataSourceUsersConfig() string {
+	return `
+resource "wallix-bastion_usergroup" "testacc_dataUsers" {
+  group_name = "testacc_dataUsers"
+  timeframes = ["allthetime"]
+}
+
+resource "wallix-bastion_user" "testacc_dataUsers" {
+  count = 3
+
+  user_name   = "testacc_dataUsers${count.index + 1}"
+  email       = "testacc-datausers${count.index + 1}@none.none"
+  profile     = "user"
+  user_auths  = ["local_password"]
+  groups      = [wallix-bastion_usergroup.testacc_dataUsers.group_name]
+  is_disabled = count.index == 2
+}
+
+data "wallix-bastion_users" "testacc_dataUsers" {
+  group           = wallix-bastion_usergroup.testacc_dataUsers.group_name
+  is_disabled     = false
+  sort_descending = true
+
+  depends_on = [wallix-bastion_user.testacc_dataUsers]
+}
+
+data "wallix-bastion_users" "testacc_dataUsersDisabled" {
+  group       = wallix-bastion_usergroup.testacc_dataUsers.group_name
+  is_disabled = true
+
+  depends_on = [wallix-bastion_user.testacc_dataUsers]
+}
+`
+}
@@ -0,0 +1,253 @@
+package bastion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ephemeralAccountCheckoutPrivateTarget is the key of the private data which
+// keeps the checked out account between Open and Close.
+const ephemeralAccountCheckoutPrivateTarget = "target"
+
+type jsonAccountCheckout struct {
+	Login    string `json:"login"`
+	Password string `json:"password"`
+	SSHKey   string `json:"ssh_key"`
+}
+
+type ephemeralAccountCheckoutModel struct {
+	AccountName     types.String `tfsdk:"account_name"`
+	DomainName      types.String `tfsdk:"domain_name"`
+	DeviceName      types.String `tfsdk:"device_name"`
+	ApplicationName types.String `tfsdk:"application_name"`
+	Duration        types.Int64  `tfsdk:"duration"`
+	Login           types.String `tfsdk:"login"`
+	Password        types.String `tfsdk:"password"`
+	SSHKey          types.String `tfsdk:"ssh_key"`
+}
+
+// ephemeralAccountCheckout checks out the credentials of a target account at
+// open and checks them in at close, so they never land in the state.
+type ephemeralAccountCheckout struct {
+	client *Client
+}
+
+func newEphemeralAccountCheckout() ephemeral.EphemeralResource {
+	return &ephemeralAccountCheckout{}
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = &ephemeralAccountCheckout{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &ephemeralAccountCheckout{}
+	_ ephemeral.EphemeralResourceWithClose          = &ephemeralAccountCheckout{}
+)
+
+func (e *ephemeralAccountCheckout) Metadata(
+	_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_account_checkout"
+}
+
+func (e *ephemeralAccountCheckout) Schema(
+	_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_name": schema.StringAttribute{
+				Required: true,
+			},
+			"domain_name": schema.StringAttribute{
+				Required: true,
+			},
+			"device_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"application_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"duration": schema.Int64Attribute{
+				Optional: true,
+			},
+			"login": schema.StringAttribute{
+				Computed: true,
+			},
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"ssh_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAccountCheckout) Configure(
+	_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse,
+) {
+	c, err := frameworkClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("configuring wallix-bastion_account_checkout", err.Error())
+
+		return
+	}
+	e.client = c
+}
+
+func (e *ephemeralAccountCheckout) ValidateConfig(
+	ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse,
+) {
+	var config ephemeralAccountCheckoutModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.DeviceName.IsNull() && !config.ApplicationName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("application_name"),
+			"Conflicting arguments", "only one of device_name or application_name can be set")
+	}
+	if !config.Duration.IsNull() && !config.Duration.IsUnknown() && config.Duration.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("duration"),
+			"Invalid argument", "duration must be at least 1")
+	}
+}
+
+func ephemeralAccountCheckoutVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("ephemeral resource wallix-bastion_account_checkout not available with api version %s", version)
+}
+
+func (e *ephemeralAccountCheckout) Open(
+	ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse,
+) {
+	if e.client == nil {
+		resp.Diagnostics.AddError("provider not configured", "the client of the provider is not available")
+
+		return
+	}
+	ctx, recorder := withFailoverRecorder(ctx)
+	defer func() { resp.Diagnostics.Append(recorder.frameworkDiagnostics(e.client)...) }()
+	if err := ephemeralAccountCheckoutVersionCheck(e.client.bastionAPIVersion); err != nil {
+		resp.Diagnostics.AddError("checking out account", err.Error())
+
+		return
+	}
+	var data ephemeralAccountCheckoutModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	target := data.AccountName.ValueString() + "@" + data.DomainName.ValueString()
+	switch {
+	case !data.DeviceName.IsNull():
+		target += "@" + data.DeviceName.ValueString()
+	case !data.ApplicationName.IsNull():
+		target += "@" + data.ApplicationName.ValueString()
+	}
+	var duration int64
+	if !data.Duration.IsNull() {
+		duration = data.Duration.ValueInt64()
+	}
+	cfg, err := checkoutAccount(ctx, target, duration, e.client)
+	if IsConflict(err) {
+		resp.Diagnostics.AddError("checking out account "+target, err.Error()+
+			"\nthe account is already checked out, wait for its check-in or the end of the checkout duration")
+
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("checking out account "+target, err.Error())
+
+		return
+	}
+	data.Login = types.StringValue(cfg.Login)
+	data.Password = types.StringValue(cfg.Password)
+	data.SSHKey = types.StringValue(cfg.SSHKey)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	privateTarget, err := json.Marshal(target)
+	if err != nil {
+		resp.Diagnostics.AddError("marshaling json", err.Error())
+
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralAccountCheckoutPrivateTarget, privateTarget)...)
+}
+
+func (e *ephemeralAccountCheckout) Close(
+	ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse,
+) {
+	privateTarget, diags := req.Private.GetKey(ctx, ephemeralAccountCheckoutPrivateTarget)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateTarget == nil {
+		return
+	}
+	var target string
+	if err := json.Unmarshal(privateTarget, &target); err != nil {
+		resp.Diagnostics.AddError("unmarshaling json", err.Error())
+
+		return
+	}
+	if e.client == nil {
+		resp.Diagnostics.AddError("provider not configured", "the client of the provider is not available")
+
+		return
+	}
+	ctx, recorder := withFailoverRecorder(ctx)
+	defer func() { resp.Diagnostics.Append(recorder.frameworkDiagnostics(e.client)...) }()
+	if err := checkinAccount(ctx, target, e.client); err != nil {
+		resp.Diagnostics.AddError("checking in account "+target, err.Error())
+	}
+}
+
+// checkoutAccount retrieves the credentials of the target account, with the
+// lock and duration of its checkout policy when duration is 0.
+func checkoutAccount(
+	ctx context.Context, target string, duration int64, c *Client,
+) (
+	jsonAccountCheckout, error,
+) {
+	var result jsonAccountCheckout
+	uri := "/targetpasswords/checkout/" + url.PathEscape(target)
+	if duration > 0 {
+		uri += "?" + url.Values{"duration": []string{strconv.FormatInt(duration, 10)}}.Encode()
+	}
+	body, code, err := c.newRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return result, err
+	}
+	if code != http.StatusOK {
+		return result, newAPIError(code, body)
+	}
+	err = json.Unmarshal([]byte(body), &result)
+	if err != nil {
+		return result, fmt.Errorf("unmarshaling json: %w", err)
+	}
+
+	return result, nil
+}
+
+func checkinAccount(ctx context.Context, target string, c *Client) error {
+	body, code, err := c.newRequest(ctx, "/targetpasswords/checkin/"+url.PathEscape(target), http.MethodGet, nil)
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK && code != http.StatusNoContent {
+		return newAPIError(code, body)
+	}
+
+	return nil
+}
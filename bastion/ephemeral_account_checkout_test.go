@@ -0,0 +1,91 @@
+package bastion_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccEphemeralAccountCheckout_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckTerraformVersion(t, "v1.10.0")
+		},
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralAccountCheckoutAccount(),
+			},
+			{
+				Config: testAccEphemeralAccountCheckoutAccount() + testAccEphemeralAccountCheckoutConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEphemeralAccountCheckoutCheckedIn(
+						"testacc_EphemeralAccountCheckout_Admin@testacc_EphemeralAccountCheckout"),
+				),
+			},
+			{
+				Config: testAccEphemeralAccountCheckoutAccount() + `
+ephemeral "wallix-bastion_account_checkout" "testacc_EphemeralAccountCheckout" {
+  account_name = "testacc_EphemeralAccountCheckout_Unknown"
+  domain_name  = wallix-bastion_domain.testacc_EphemeralAccountCheckout.domain_name
+}
+`,
+				ExpectError: regexp.MustCompile(`checking out account`),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+// testAccCheckEphemeralAccountCheckoutCheckedIn checks that the account has
+// been checked out then checked in, which only the fake bastion reports.
+func testAccCheckEphemeralAccountCheckoutCheckedIn(target string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if testAccFakeBastion == nil {
+			return nil
+		}
+		testAccFakeBastion.mutex.Lock()
+		defer testAccFakeBastion.mutex.Unlock()
+		if testAccFakeBastion.checkouts[target] == 0 {
+			return fmt.Errorf("account %s never checked out", target)
+		}
+		if testAccFakeBastion.checkedOut[target] {
+			return fmt.Errorf("account %s not checked in", target)
+		}
+
+		return nil
+	}
+}
+
+func testAccEphemeralAccountCheckoutAccount() string {
+	return `
+resource "wallix-bastion_domain" "testacc_EphemeralAccountCheckout" {
+  domain_name = "testacc_EphemeralAccountCheckout"
+}
+resource "wallix-bastion_domain_account" "testacc_EphemeralAccountCheckout" {
+  domain_id     = wallix-bastion_domain.testacc_EphemeralAccountCheckout.id
+  account_name  = "testacc_EphemeralAccountCheckout_Admin"
+  account_login = "admin"
+}
+resource "wallix-bastion_domain_account_credential" "testacc_EphemeralAccountCheckout" {
+  domain_id  = wallix-bastion_domain.testacc_EphemeralAccountCheckout.id
+  account_id = wallix-bastion_domain_account.testacc_EphemeralAccountCheckout.id
+  type       = "password"
+  password   = "aPassword_1"
+}
+`
+}
+
+func testAccEphemeralAccountCheckoutConfig() string {
+	return `
+ephemeral "wallix-bastion_account_checkout" "testacc_EphemeralAccountCheckout" {
+  account_name = wallix-bastion_domain_account.testacc_EphemeralAccountCheckout.account_name
+  domain_name  = wallix-bastion_domain.testacc_EphemeralAccountCheckout.domain_name
+  duration     = 600
+}
+`
+}
@@ -0,0 +1,200 @@
+package bastion
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// APIError is an unexpected response of the bastion API.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Reason     string
+	Details    []APIErrorDetail
+	Body       string
+}
+
+// APIErrorDetail is an error on a specific field of the request.
+type APIErrorDetail struct {
+	Field   string
+	Message string
+}
+
+type jsonAPIError struct {
+	Error       string          `json:"error"`
+	Description string          `json:"description"`
+	Reason      string          `json:"reason"`
+	Details     json.RawMessage `json:"details"`
+}
+
+type jsonAPIErrorDetail struct {
+	Field       string `json:"field"`
+	Message     string `json:"message"`
+	Description string `json:"description"`
+}
+
+// newAPIError parses the error payload returned by the bastion with an unexpected status code.
+func newAPIError(statusCode int, body string) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Body:       body,
+	}
+	var payload jsonAPIError
+	if err := json.Unmarshal([]byte(body), &payload); err != nil {
+		return apiErr
+	}
+	apiErr.Code = payload.Error
+	apiErr.Message = payload.Description
+	apiErr.Reason = payload.Reason
+	apiErr.Details = parseAPIErrorDetails(payload.Details)
+
+	return apiErr
+}
+
+// parseAPIErrorDetails reads field errors sent either as a list of objects
+// or as a map of field name to message.
+func parseAPIErrorDetails(raw json.RawMessage) []APIErrorDetail {
+	if len(raw) == 0 {
+		return nil
+	}
+	var list []jsonAPIErrorDetail
+	if err := json.Unmarshal(raw, &list); err == nil {
+		details := make([]APIErrorDetail, 0, len(list))
+		for _, v := range list {
+			message := v.Message
+			if message == "" {
+				message = v.Description
+			}
+			details = append(details, APIErrorDetail{Field: v.Field, Message: message})
+		}
+
+		return details
+	}
+	var fields map[string]string
+	if err := json.Unmarshal(raw, &fields); err == nil {
+		details := make([]APIErrorDetail, 0, len(fields))
+		for k, v := range fields {
+			details = append(details, APIErrorDetail{Field: k, Message: v})
+		}
+		sort.Slice(details, func(i, j int) bool {
+			return details[i].Field < details[j].Field
+		})
+
+		return details
+	}
+
+	return nil
+}
+
+func (e *APIError) summary() string {
+	summary := fmt.Sprintf("api returns %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Code != "" && e.Code != http.StatusText(e.StatusCode) {
+		summary += ": " + e.Code
+	}
+
+	return summary
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" && e.Message == "" && e.Reason == "" && len(e.Details) == 0 {
+		return fmt.Sprintf("%s with body:\n%s", e.summary(), e.Body)
+	}
+	var msg strings.Builder
+	msg.WriteString(e.summary())
+	if e.Message != "" {
+		msg.WriteString(": " + e.Message)
+	}
+	if e.Reason != "" {
+		msg.WriteString(" (" + e.Reason + ")")
+	}
+	for _, v := range e.Details {
+		msg.WriteString("\n" + v.Field + ": " + v.Message)
+	}
+
+	return msg.String()
+}
+
+// IsNotFound reports whether err is an APIError with the 404 status.
+func IsNotFound(err error) bool {
+	return hasAPIErrorStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError with the 409 status.
+func IsConflict(err error) bool {
+	return hasAPIErrorStatus(err, http.StatusConflict)
+}
+
+func hasAPIErrorStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// diagFromErr converts an error to diagnostics with one diagnostic
+// by field in error when the bastion details them.
+func diagFromErr(err error) diag.Diagnostics {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Details) == 0 {
+		return diag.FromErr(err)
+	}
+	detail := apiErr.Message
+	if apiErr.Reason != "" {
+		detail = strings.TrimSpace(detail + " (" + apiErr.Reason + ")")
+	}
+	diags := make(diag.Diagnostics, 0, len(apiErr.Details))
+	for _, v := range apiErr.Details {
+		fieldDiag := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  apiErr.summary(),
+			Detail:   strings.TrimSpace(v.Message + "\n" + detail),
+		}
+		// keep only the top-level attribute, nested paths of the API
+		// don't always match the schema (sets, renamed blocks)
+		if field := strings.FieldsFunc(v.Field, func(r rune) bool {
+			return r == '.' || r == '['
+		}); len(field) > 0 {
+			fieldDiag.AttributePath = cty.GetAttrPath(field[0])
+		}
+		diags = append(diags, fieldDiag)
+	}
+
+	return diags
+}
+
+// frameworkDiagFromErr is diagFromErr for the resources of
+// terraform-plugin-framework, with summary for errors without details.
+func frameworkDiagFromErr(summary string, err error) fwdiag.Diagnostics {
+	var diags fwdiag.Diagnostics
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Details) == 0 {
+		diags.AddError(summary, err.Error())
+
+		return diags
+	}
+	detail := apiErr.Message
+	if apiErr.Reason != "" {
+		detail = strings.TrimSpace(detail + " (" + apiErr.Reason + ")")
+	}
+	for _, v := range apiErr.Details {
+		fieldDetail := strings.TrimSpace(v.Message + "\n" + detail)
+		if field := strings.FieldsFunc(v.Field, func(r rune) bool {
+			return r == '.' || r == '['
+		}); len(field) > 0 {
+			diags.AddAttributeError(path.Root(field[0]), apiErr.summary(), fieldDetail)
+		} else {
+			diags.AddError(apiErr.summary(), fieldDetail)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,101 @@
+package bastion
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestNewAPIError(t *testing.T) {
+	apiErr := newAPIError(http.StatusBadRequest, `{
+  "error": "Invalid data",
+  "description": "Invalid value",
+  "reason": "unknown subprotocol",
+  "details": [{"field": "subprotocols", "message": "SSH_FOO is not a valid subprotocol"}]
+}`)
+	if apiErr.Code != "Invalid data" || apiErr.Message != "Invalid value" || apiErr.Reason != "unknown subprotocol" {
+		t.Errorf("unexpected parsing: %+v", apiErr)
+	}
+	if len(apiErr.Details) != 1 || apiErr.Details[0].Field != "subprotocols" {
+		t.Fatalf("unexpected details: %+v", apiErr.Details)
+	}
+
+	wrapped := fmt.Errorf("adding authorization: %w", apiErr)
+	var target *APIError
+	if !errors.As(wrapped, &target) || target.StatusCode != http.StatusBadRequest {
+		t.Errorf("errors.As doesn't find the APIError in %v", wrapped)
+	}
+	diags := diagFromErr(wrapped)
+	if len(diags) != 1 {
+		t.Fatalf("got %d diagnostics, want 1", len(diags))
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("subprotocols")) {
+		t.Errorf("got attribute path %#v, want subprotocols", diags[0].AttributePath)
+	}
+}
+
+func TestNewAPIErrorDetailsMap(t *testing.T) {
+	apiErr := newAPIError(http.StatusBadRequest,
+		`{"error": "Bad Request", "details": {"user_name": "required", "session_accounts.0.account": "unknown"}}`)
+	diags := diagFromErr(apiErr)
+	if len(diags) != 2 {
+		t.Fatalf("got %d diagnostics, want 2", len(diags))
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("session_accounts")) {
+		t.Errorf("got attribute path %#v, want session_accounts", diags[0].AttributePath)
+	}
+	if !diags[1].AttributePath.Equals(cty.GetAttrPath("user_name")) {
+		t.Errorf("got attribute path %#v, want user_name", diags[1].AttributePath)
+	}
+}
+
+func TestNewAPIErrorRawBody(t *testing.T) {
+	apiErr := newAPIError(http.StatusBadGateway, "<html>Bad Gateway</html>")
+	if !strings.Contains(apiErr.Error(), "<html>Bad Gateway</html>") {
+		t.Errorf("raw body missing from error: %s", apiErr.Error())
+	}
+	if diags := diagFromErr(apiErr); len(diags) != 1 || diags[0].AttributePath != nil {
+		t.Errorf("unexpected diagnostics: %+v", diags)
+	}
+}
+
+func TestAPIErrorPredicates(t *testing.T) {
+	notFound := fmt.Errorf("reading user: %w", newAPIError(http.StatusNotFound, `{"error": "Not Found"}`))
+	if !IsNotFound(notFound) || IsConflict(notFound) {
+		t.Errorf("IsNotFound/IsConflict wrong for %v", notFound)
+	}
+	conflict := newAPIError(http.StatusConflict, `{"error": "Conflict"}`)
+	if !IsConflict(conflict) || IsNotFound(conflict) {
+		t.Errorf("IsNotFound/IsConflict wrong for %v", conflict)
+	}
+	if IsNotFound(errors.New("not found")) || IsNotFound(nil) {
+		t.Error("IsNotFound true for an error which isn't an APIError")
+	}
+}
+
+func TestFrameworkDiagFromErr(t *testing.T) {
+	apiErr := newAPIError(http.StatusBadRequest,
+		`{"error": "Bad Request", "details": {"user_name": "required", "session_accounts.0.account": "unknown"}}`)
+	diags := frameworkDiagFromErr("adding user", apiErr)
+	if len(diags) != 2 {
+		t.Fatalf("got %d diagnostics, want 2", len(diags))
+	}
+	if v, ok := diags[0].(fwdiag.DiagnosticWithPath); !ok || !v.Path().Equal(path.Root("session_accounts")) {
+		t.Errorf("got diagnostic %#v, want one on session_accounts", diags[0])
+	}
+	if v, ok := diags[1].(fwdiag.DiagnosticWithPath); !ok || !v.Path().Equal(path.Root("user_name")) {
+		t.Errorf("got diagnostic %#v, want one on user_name", diags[1])
+	}
+
+	diags = frameworkDiagFromErr("adding user", newAPIError(http.StatusBadGateway, "<html>Bad Gateway</html>"))
+	if len(diags) != 1 || diags[0].Summary() != "adding user" ||
+		!strings.Contains(diags[0].Detail(), "<html>Bad Gateway</html>") {
+		t.Errorf("unexpected diagnostics: %+v", diags)
+	}
+}
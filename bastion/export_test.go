@@ -0,0 +1,7 @@
+package bastion
+
+// SplitTestSearchQuery is splitTestSearchQuery for the fake bastion of the
+// acceptance tests.
+func SplitTestSearchQuery(query string) [][2]string {
+	return splitTestSearchQuery(query)
+}
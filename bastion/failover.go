@@ -0,0 +1,217 @@
+package bastion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// bastionNodes returns the host:port of the nodes of the bastion, from hosts
+// when the list is set, otherwise from ip. A host without port uses port.
+func bastionNodes(ip string, hosts []string, port int) []string {
+	if len(hosts) == 0 {
+		hosts = []string{ip}
+	}
+	nodes := make([]string, 0, len(hosts))
+	for _, v := range hosts {
+		if _, _, err := net.SplitHostPort(v); err == nil {
+			nodes = append(nodes, v)
+
+			continue
+		}
+		nodes = append(nodes, net.JoinHostPort(v, strconv.Itoa(port)))
+	}
+
+	return nodes
+}
+
+// activeNode returns the index and host:port of the node which answered the
+// last request, the first one at start.
+func (c *Client) activeNode() (int, string) {
+	c.nodeMutex.Lock()
+	defer c.nodeMutex.Unlock()
+
+	return c.nodeIndex, c.bastionNodes[c.nodeIndex]
+}
+
+func (c *Client) setActiveNode(index int) {
+	c.nodeMutex.Lock()
+	defer c.nodeMutex.Unlock()
+	c.nodeIndex = index
+}
+
+// sendRequestFailover sends the request to the active node and, when it is
+// unreachable or fails with a 5xx, to the next nodes in order. The node
+// which answers becomes the active one for the next requests.
+func (c *Client) sendRequestFailover(
+	ctx context.Context, uri string, method string, body []byte,
+) (
+	string, int, http.Header, error,
+) {
+	first, _ := c.activeNode()
+	var (
+		respBody string
+		code     int
+		header   http.Header
+		err      error
+	)
+	for i := range c.bastionNodes {
+		index := (first + i) % len(c.bastionNodes)
+		node := c.bastionNodes[index]
+		respBody, code, header, err = c.sendRequest(ctx, c.nodeURL(node)+uri, method, body)
+		if i == len(c.bastionNodes)-1 || !isFailoverRequest(method, code, err) {
+			if i > 0 && !isFailoverRequest(method, code, err) {
+				c.setActiveNode(index)
+			}
+
+			return respBody, code, header, err
+		}
+		next := c.bastionNodes[(index+1)%len(c.bastionNodes)]
+		reason := http.StatusText(code)
+		if err != nil {
+			reason = err.Error()
+		} else if reason == "" {
+			reason = "status " + strconv.Itoa(code)
+		}
+		recordFailover(ctx, node, next, reason)
+	}
+
+	return respBody, code, header, err
+}
+
+// isFailoverRequest reports whether a request can be sent to the next node.
+// Connection failures and 5xx are retried on another node for idempotent
+// methods, others only when the connection couldn't be opened, as the node
+// can't have processed them.
+func isFailoverRequest(method string, code int, err error) bool {
+	idempotent := slices.Contains([]string{
+		http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete,
+	}, method)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+
+		return idempotent
+	}
+
+	return idempotent && code >= http.StatusInternalServerError
+}
+
+// failoverRecorder collects the failovers which occurred during a call of a
+// resource or data source function to report them as diagnostics.
+type failoverRecorder struct {
+	mutex    sync.Mutex
+	messages []string
+}
+
+type failoverRecorderKey struct{}
+
+func withFailoverRecorder(ctx context.Context) (context.Context, *failoverRecorder) {
+	recorder := &failoverRecorder{}
+
+	return context.WithValue(ctx, failoverRecorderKey{}, recorder), recorder
+}
+
+func recordFailover(ctx context.Context, from, to, reason string) {
+	message := fmt.Sprintf("bastion node %s failed (%s), failed over to %s", from, reason, to)
+	tflog.Warn(ctx, message)
+	recorder, ok := ctx.Value(failoverRecorderKey{}).(*failoverRecorder)
+	if !ok {
+		return
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	if !slices.Contains(recorder.messages, message) {
+		recorder.messages = append(recorder.messages, message)
+	}
+}
+
+func (r *failoverRecorder) diagnostics(m interface{}) diag.Diagnostics {
+	warnings := r.warnings(m)
+	if len(warnings) == 0 {
+		return nil
+	}
+	diags := make(diag.Diagnostics, 0, len(warnings))
+	for _, v := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  failoverWarningSummary,
+			Detail:   v,
+		})
+	}
+
+	return diags
+}
+
+// frameworkDiagnostics is diagnostics for the resources of
+// terraform-plugin-framework.
+func (r *failoverRecorder) frameworkDiagnostics(m interface{}) fwdiag.Diagnostics {
+	var diags fwdiag.Diagnostics
+	for _, v := range r.warnings(m) {
+		diags.AddWarning(failoverWarningSummary, v)
+	}
+
+	return diags
+}
+
+const failoverWarningSummary = "Bastion node failover"
+
+// warnings returns the failovers recorded with the node serving the requests
+// now.
+func (r *failoverRecorder) warnings(m interface{}) []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.messages) == 0 {
+		return nil
+	}
+	var served string
+	if c, ok := m.(*Client); ok {
+		_, served = c.activeNode()
+	}
+	warnings := make([]string, len(r.messages))
+	for i, v := range r.messages {
+		warnings[i] = v + "; requests are now served by " + served
+	}
+
+	return warnings
+}
+
+// withFailoverDiagnostics wraps the functions of a resource or data source to
+// add a warning for each failover between bastion nodes during their call.
+func withFailoverDiagnostics(r *schema.Resource) *schema.Resource {
+	wrap := func(
+		f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+	) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			ctx, recorder := withFailoverRecorder(ctx)
+			diags := f(ctx, d, m)
+
+			return append(diags, recorder.diagnostics(m)...)
+		}
+	}
+	r.CreateContext = wrap(r.CreateContext)
+	r.ReadContext = wrap(r.ReadContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+	r.DeleteContext = wrap(r.DeleteContext)
+
+	return r
+}
@@ -0,0 +1,134 @@
+package bastion
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBastionNodes(t *testing.T) {
+	got := bastionNodes("ignored", []string{"node1", "node2:8443", "::1", "[::1]:444"}, 443)
+	want := []string{"node1:443", "node2:8443", "[::1]:443", "[::1]:444"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := bastionNodes("bastion", nil, 443); len(got) != 1 || got[0] != "bastion:443" {
+		t.Errorf("got %v, want [bastion:443]", got)
+	}
+}
+
+func TestClientFailover(t *testing.T) {
+	var primaryCalls atomic.Int32
+	primary := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		primaryCalls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer primary.Close()
+	secondary := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer secondary.Close()
+	// a closed server refuses connections like a node in maintenance
+	down := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	down.Close()
+
+	client := newTestClient(t, primary)
+	client.maxRetries = 0
+	client.bastionNodes = []string{
+		strings.TrimPrefix(down.URL, "https://"),
+		strings.TrimPrefix(primary.URL, "https://"),
+		strings.TrimPrefix(secondary.URL, "https://"),
+	}
+	resource := withFailoverDiagnostics(&schema.Resource{
+		ReadContext: func(ctx context.Context, _ *schema.ResourceData, m interface{}) diag.Diagnostics {
+			_, code, err := m.(*Client).newRequest(ctx, "/users/", http.MethodGet, nil)
+			if err != nil || code != http.StatusOK {
+				t.Errorf("unexpected result: %d, %v", code, err)
+			}
+
+			return nil
+		},
+	})
+	diags := resource.ReadContext(context.Background(), nil, client)
+	if len(diags) != 2 || diags.HasError() {
+		t.Fatalf("got diagnostics %+v, want 2 warnings", diags)
+	}
+	if !strings.Contains(diags[1].Detail, "Service Unavailable") ||
+		!strings.Contains(diags[1].Detail, client.bastionNodes[2]) {
+		t.Errorf("unexpected failover diagnostic: %s", diags[1].Detail)
+	}
+	if index, _ := client.activeNode(); index != 2 {
+		t.Errorf("active node is %d, want 2", index)
+	}
+
+	// the active node is remembered for the next requests
+	diags = resource.ReadContext(context.Background(), nil, client)
+	if len(diags) != 0 || primaryCalls.Load() != 1 {
+		t.Errorf("got diagnostics %+v and %d calls to primary, want none and 1", diags, primaryCalls.Load())
+	}
+}
+
+func TestClientFailoverNotIdempotent(t *testing.T) {
+	var secondaryCalls atomic.Int32
+	primary := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer primary.Close()
+	secondary := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		secondaryCalls.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer secondary.Close()
+
+	client := newTestClient(t, primary)
+	client.maxRetries = 0
+	client.bastionNodes = append(client.bastionNodes, strings.TrimPrefix(secondary.URL, "https://"))
+	_, code, err := client.newRequest(context.Background(), "/users/", http.MethodPost, nil)
+	if err != nil || code != http.StatusInternalServerError {
+		t.Errorf("unexpected result: %d, %v", code, err)
+	}
+	if secondaryCalls.Load() != 0 {
+		t.Errorf("POST failed over to the secondary node after a 500")
+	}
+}
+
+func TestFrameworkFailoverDiagnostics(t *testing.T) {
+	secondary := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer secondary.Close()
+	down := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	down.Close()
+
+	client := newTestClient(t, secondary)
+	client.maxRetries = 0
+	client.bastionNodes = []string{
+		strings.TrimPrefix(down.URL, "https://"),
+		strings.TrimPrefix(secondary.URL, "https://"),
+	}
+	var resp fwresource.ImportStateResponse
+	(&resourceUser{client: client}).ImportState(context.Background(),
+		fwresource.ImportStateRequest{ID: "testacc"}, &resp)
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("got diagnostics %+v, want 1 error and 1 warning", resp.Diagnostics)
+	}
+	warning := resp.Diagnostics.Warnings()[0]
+	if warning.Summary() != failoverWarningSummary || !strings.Contains(warning.Detail(), client.bastionNodes[1]) {
+		t.Errorf("unexpected failover diagnostic: %s", warning.Detail())
+	}
+
+	// without a configured provider
+	resp = fwresource.ImportStateResponse{}
+	(&resourceUser{}).ImportState(context.Background(), fwresource.ImportStateRequest{ID: "testacc"}, &resp)
+	if resp.Diagnostics.ErrorsCount() != 1 ||
+		resp.Diagnostics.Errors()[0].Summary() != "provider not configured" {
+		t.Errorf("got diagnostics %+v, want the provider not configured error", resp.Diagnostics)
+	}
+}
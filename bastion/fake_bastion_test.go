@@ -0,0 +1,640 @@
+package bastion_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/wallix/terraform-provider-wallix-bastion/bastion"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// fakeBastionNameFields maps a collection of the API to the field which
+// identifies its objects by name, in queries and in paths.
+var fakeBastionNameFields = map[string]string{ //nolint: gochecknoglobals
+	"accounts":               "account_name",
+	"applications":           "application_name",
+	"approvals":              "ticket",
+	"authdomains":            "domain_name",
+	"authorizations":         "authorization_name",
+	"checkoutpolicies":       "checkout_policy_name",
+	"clusters":               "cluster_name",
+	"configoptions":          "config_id",
+	"connectionmessages":     "message_name",
+	"connectionpolicies":     "connection_policy_name",
+	"credentials":            "type",
+	"devices":                "device_name",
+	"domains":                "domain_name",
+	"externalauths":          "authentication_name",
+	"localdomains":           "domain_name",
+	"localpasswordpolicies":  "password_policy_name",
+	"mappings":               "user_group",
+	"passwordchangepolicies": "password_change_policy_name",
+	"profiles":               "profile_name",
+	"services":               "service_name",
+	"targetgroups":           "group_name",
+	"timeframes":             "timeframe_name",
+	"usergroups":             "group_name",
+	"users":                  "user_name",
+}
+
+// fakeBastionEmbeds maps a collection to its sub-collections that the API
+// returns inline with each object.
+var fakeBastionEmbeds = map[string]map[string]string{ //nolint: gochecknoglobals
+	"accounts":     {"credentials": "credentials"},
+	"applications": {"local_domains": "localdomains"},
+	"devices":      {"local_domains": "localdomains", "services": "services"},
+}
+
+// fakeBastionWriteOnly maps a collection to the fields that the API accepts
+// but never returns.
+var fakeBastionWriteOnly = map[string][]string{ //nolint: gochecknoglobals
+	"credentials": {"password", "private_key", "passphrase"},
+}
+
+// fakeBastionDefaults maps a collection to the values that the API sets on
+// new objects when they are missing from the request.
+var fakeBastionDefaults = map[string]map[string]interface{}{ //nolint: gochecknoglobals
+	"authorizations": {"active_quorum": -1, "inactive_quorum": -1},
+}
+
+// fakeBastionTrimmed maps a singleton to the fields that the API stores
+// without their trailing newline, like the PEM contents of the x509 config.
+var fakeBastionTrimmed = map[string][]string{ //nolint: gochecknoglobals
+	"/config/x509": {"ca_certificate", "server_public_key", "server_private_key"},
+}
+
+// fakeBastion is an in-memory implementation of the bastion REST API to run
+// acceptance tests without an appliance.
+// Collections (paths ending with /) accept GET with q/limit/offset and POST,
+// their objects GET, PUT and DELETE by id or by name.
+// Other paths are singletons which keep the last PUT or POST body.
+type fakeBastion struct {
+	*httptest.Server
+
+	mutex       sync.Mutex
+	lastID      int
+	collections map[string][]map[string]interface{}
+	singletons  map[string]map[string]interface{}
+	// checkouts counts the checkouts of each target account and
+	// checkedOut keeps the ones not checked in yet.
+	checkouts  map[string]int
+	checkedOut map[string]bool
+	// tlsServer serves the same API over TLS when started.
+	tlsServer *httptest.Server
+}
+
+func newFakeBastion() *fakeBastion {
+	f := &fakeBastion{
+		collections: make(map[string][]map[string]interface{}),
+		singletons:  make(map[string]map[string]interface{}),
+		checkouts:   make(map[string]int),
+		checkedOut:  make(map[string]bool),
+	}
+	f.seed()
+	f.Server = httptest.NewServer(f)
+
+	return f
+}
+
+// startTLS serves the fake bastion over TLS too, with a self-signed
+// certificate.
+func (f *fakeBastion) startTLS() {
+	f.tlsServer = httptest.NewTLSServer(f)
+}
+
+// seed adds the built-in objects of a bastion that tests rely on.
+func (f *fakeBastion) seed() {
+	f.add("/profiles/", map[string]interface{}{"profile_name": "user"})
+	f.add("/profiles/", map[string]interface{}{"profile_name": "product_administrator"})
+	f.add("/timeframes/", map[string]interface{}{"timeframe_name": "allthetime", "periods": []interface{}{}})
+	for _, v := range []string{"SSH", "RDP", "JumpHost", "VNC", "TELNET", "RLOGIN", "RAWTCPIP"} {
+		f.add("/connectionpolicies/", map[string]interface{}{
+			"connection_policy_name": v,
+			"protocol":               v,
+			"authentication_methods": []interface{}{},
+			"options":                map[string]interface{}{},
+		})
+	}
+	for _, v := range []string{"login_en", "login_fr", "motd_en", "motd_fr"} {
+		f.add("/connectionmessages/", map[string]interface{}{"message_name": v, "message": ""})
+	}
+	f.add("/localpasswordpolicies/", map[string]interface{}{
+		"password_policy_name":         "default",
+		"allow_same_user_and_password": false,
+		"password_expiration":          0,
+		"password_warning_days":        0,
+		"password_min_length":          8,
+		"password_min_lower_chars":     0,
+		"password_min_upper_chars":     0,
+		"password_min_digit_chars":     0,
+		"password_min_special_chars":   0,
+		"last_passwords_to_reject":     0,
+		"max_auth_failures":            0,
+		"forbidden_passwords":          []interface{}{"wallix"},
+		"ssh_key_algos_allowed":        []interface{}{"ssh-ed25519", "ecdsa-sha2-nistp256", "ssh-rsa"},
+		"ssh_rsa_min_length":           3072,
+	})
+	f.add("/configoptions/", map[string]interface{}{
+		"config_id":   "wabengine",
+		"config_name": "wabengine",
+		"name":        "Global",
+		"date":        "2024-01-01 00:00:00",
+		"options": []interface{}{
+			map[string]interface{}{"name": "one_time_password_ttl", "value": 60},
+		},
+	})
+	f.singletons["/encryption"] = map[string]interface{}{"encryption": "ready"}
+	for _, v := range []map[string]interface{}{
+		{"ticket": "INC-1", "user_name": "jdoe", "target_name": "root@srv1:SSH", "status": "pending"},
+		{"ticket": "INC-2", "user_name": "asmith", "target_name": "admin@srv2:RDP", "status": "pending"},
+		{"ticket": "INC-0", "user_name": "jdoe", "target_name": "root@srv1:SSH", "status": "accepted"},
+	} {
+		v["creation"] = "2024-01-01 00:00:00"
+		v["begin"] = "2024-01-01 00:00:00"
+		v["end"] = "2024-01-01 01:00:00"
+		v["duration"] = 3600
+		v["comment"] = "break-glass access"
+		v["email"] = v["user_name"].(string) + "@example.com"
+		v["language"] = "en"
+		v["quorum"] = 1
+		v["answers"] = []interface{}{}
+		f.add("/approvals/", v)
+	}
+}
+
+func (f *fakeBastion) add(collection string, object map[string]interface{}) map[string]interface{} {
+	f.lastID++
+	if _, ok := object["id"]; !ok {
+		object["id"] = fmt.Sprintf("%032x", f.lastID)
+	}
+	f.collections[collection] = append(f.collections[collection], object)
+
+	return object
+}
+
+func (f *fakeBastion) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	switch r.URL.Path {
+	case "/api/version":
+		fakeBastionWrite(w, http.StatusOK, map[string]interface{}{
+			"version":              "3.12",
+			"version_decimal":      3.12,
+			"wab_version":          "12.0",
+			"wab_version_decimal":  12.0,
+			"wab_complete_version": "12.0.0",
+		})
+
+		return
+	case "/api/logout":
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+	apiPath, ok := strings.CutPrefix(r.URL.Path, "/api/")
+	if !ok {
+		fakeBastionError(w, http.StatusNotFound, "unknown path "+r.URL.Path)
+
+		return
+	}
+	_, apiPath, _ = strings.Cut(apiPath, "/") // drop the API version
+	apiPath = "/" + apiPath
+	var body map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			fakeBastionError(w, http.StatusBadRequest, "invalid json: "+err.Error())
+
+			return
+		}
+	}
+	if action, target, ok := strings.Cut(strings.TrimPrefix(apiPath, "/targetpasswords/"), "/"); ok &&
+		strings.HasPrefix(apiPath, "/targetpasswords/") {
+		f.serveTargetPassword(w, r, action, target)
+
+		return
+	}
+	if key, ok := strings.CutPrefix(apiPath, "/approvals/assignments"); ok {
+		f.serveApprovalAssignments(w, r, strings.Trim(key, "/"), body)
+
+		return
+	}
+
+	if _, ok := fakeBastionNameFields[path.Base(apiPath)]; ok && !strings.HasSuffix(apiPath, "/") {
+		apiPath += "/" // the provider posts to some collections without the trailing slash
+	}
+	if strings.HasSuffix(apiPath, "/") {
+		f.serveCollection(w, r, apiPath, body)
+
+		return
+	}
+	collection, key := path.Split(apiPath)
+	if _, ok := fakeBastionNameFields[path.Base(collection)]; ok {
+		f.serveObject(w, r, collection, key, body)
+
+		return
+	}
+	f.serveSingleton(w, r, apiPath, body)
+}
+
+func (f *fakeBastion) serveCollection(
+	w http.ResponseWriter, r *http.Request, collection string, body map[string]interface{},
+) {
+	var parent map[string]interface{}
+	if parentPath := path.Dir(strings.TrimSuffix(collection, "/")); parentPath != "/" {
+		parentCollection, key := path.Split(parentPath)
+		var idx int
+		if parent, idx = f.find(parentCollection, key); idx < 0 {
+			fakeBastionError(w, http.StatusNotFound, "parent object "+key+" not found")
+
+			return
+		}
+	}
+	nameField := fakeBastionNameFields[path.Base(collection)]
+	switch r.Method {
+	case http.MethodGet:
+		f.serveList(w, r, collection, "")
+	case http.MethodPost:
+		if name, ok := body[nameField].(string); ok && nameField != "type" {
+			if _, idx := f.find(collection, name); idx >= 0 {
+				fakeBastionError(w, http.StatusBadRequest, nameField+" "+name+" already exists")
+
+				return
+			}
+		}
+		for k, v := range fakeBastionDefaults[path.Base(collection)] {
+			if _, ok := body[k]; !ok {
+				body[k] = v
+			}
+		}
+		if path.Base(collection) == "mappings" {
+			body["domain"] = parent["domain_name"]
+		}
+		fakeBastionPublicKey(collection, body)
+		object := f.add(collection, body)
+		fakeBastionWrite(w, http.StatusOK, map[string]interface{}{"id": object["id"]})
+	default:
+		fakeBastionError(w, http.StatusMethodNotAllowed, r.Method+" not allowed on "+collection)
+	}
+}
+
+func (f *fakeBastion) serveObject(
+	w http.ResponseWriter, r *http.Request, collection, key string, body map[string]interface{},
+) {
+	object, idx := f.find(collection, key)
+	if idx < 0 {
+		fakeBastionError(w, http.StatusNotFound, key+" not found in "+collection)
+
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		fakeBastionWrite(w, http.StatusOK, f.embed(collection, object))
+	case http.MethodPut:
+		for k, v := range body {
+			object[k] = v
+		}
+		fakeBastionPublicKey(collection, object)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		if collection == "/localpasswordpolicies/" && object["password_policy_name"] == "default" {
+			fakeBastionError(w, http.StatusBadRequest, "the default local password policy can't be deleted")
+
+			return
+		}
+		prefix := collection + fmt.Sprint(object["id"]) + "/"
+		for k := range f.collections {
+			if strings.HasPrefix(k, prefix) {
+				delete(f.collections, k)
+			}
+		}
+		f.collections[collection] = append(f.collections[collection][:idx], f.collections[collection][idx+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		fakeBastionError(w, http.StatusMethodNotAllowed, r.Method+" not allowed on "+collection+key)
+	}
+}
+
+func (f *fakeBastion) serveSingleton(
+	w http.ResponseWriter, r *http.Request, apiPath string, body map[string]interface{},
+) {
+	switch r.Method {
+	case http.MethodGet:
+		object, ok := f.singletons[apiPath]
+		if !ok {
+			fakeBastionError(w, http.StatusNotFound, apiPath+" not found")
+
+			return
+		}
+		fakeBastionWrite(w, http.StatusOK, object)
+	case http.MethodPost, http.MethodPut:
+		object, ok := f.singletons[apiPath]
+		if !ok {
+			object = make(map[string]interface{})
+			f.singletons[apiPath] = object
+		}
+		for k, v := range body {
+			if str, ok := v.(string); ok && slices.Contains(fakeBastionTrimmed[apiPath], k) {
+				v = strings.TrimRight(str, "\n")
+			}
+			object[k] = v
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		delete(f.singletons, apiPath)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		fakeBastionError(w, http.StatusMethodNotAllowed, r.Method+" not allowed on "+apiPath)
+	}
+}
+
+// serveList writes the objects of collection matching the query of r, and
+// the extra criteria when not empty, with the sort and paging of r.
+func (f *fakeBastion) serveList(w http.ResponseWriter, r *http.Request, collection, extra string) {
+	results := make([]map[string]interface{}, 0)
+	for _, v := range f.collections[collection] {
+		if fakeBastionMatch(v, r.URL.Query().Get("q")) && fakeBastionMatch(v, extra) {
+			results = append(results, f.embed(collection, v))
+		}
+	}
+	fakeBastionSort(results, r.URL.Query().Get("sort"))
+	if offset, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil {
+		results = results[min(offset, len(results)):]
+	}
+	if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit >= 0 {
+		results = results[:min(limit, len(results))]
+	}
+	fakeBastionWrite(w, http.StatusOK, results)
+}
+
+// serveApprovalAssignments lists the pending approvals and records the
+// answer to one of them, as the only approver of every approval.
+func (f *fakeBastion) serveApprovalAssignments(
+	w http.ResponseWriter, r *http.Request, key string, body map[string]interface{},
+) {
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		f.serveList(w, r, "/approvals/", "status=pending")
+	case key != "" && r.Method == http.MethodPut:
+		object, idx := f.find("/approvals/", key)
+		if idx < 0 {
+			fakeBastionError(w, http.StatusNotFound, key+" not found in /approvals/")
+
+			return
+		}
+		if object["status"] != "pending" {
+			fakeBastionError(w, http.StatusBadRequest, "approval "+key+" is not pending")
+
+			return
+		}
+		approved, _ := body["answer"].(bool)
+		object["answers"] = append(object["answers"].([]interface{}), map[string]interface{}{
+			"approved":       approved,
+			"approver_name":  "admin",
+			"approver_email": "admin@example.com",
+			"answer_date":    "2024-01-01 00:05:00",
+			"comment":        body["comment"],
+		})
+		object["status"] = "rejected"
+		if approved {
+			object["status"] = "accepted"
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		fakeBastionError(w, http.StatusMethodNotAllowed, r.Method+" not allowed on /approvals/assignments/"+key)
+	}
+}
+
+// serveTargetPassword checks out the credentials of a target account
+// (account@domain, account@domain@device or account@domain@application)
+// with a lock until its check in.
+func (f *fakeBastion) serveTargetPassword(w http.ResponseWriter, r *http.Request, action, target string) {
+	credentials := f.targetCredentials(target)
+	switch {
+	case r.Method != http.MethodGet:
+		fakeBastionError(w, http.StatusMethodNotAllowed, r.Method+" not allowed on /targetpasswords/"+action)
+	case credentials == "":
+		fakeBastionError(w, http.StatusNotFound, "target account "+target+" not found")
+	case action == "checkout" && f.checkedOut[target]:
+		fakeBastionError(w, http.StatusConflict, "target account "+target+" is already checked out")
+	case action == "checkout":
+		f.checkouts[target]++
+		f.checkedOut[target] = true
+		result := map[string]interface{}{"login": strings.Split(target, "@")[0], "password": "", "ssh_key": ""}
+		for _, v := range f.collections[credentials] {
+			switch v["type"] {
+			case "password":
+				result["password"] = v["password"]
+			case "ssh_key":
+				result["ssh_key"] = v["private_key"]
+			}
+		}
+		fakeBastionWrite(w, http.StatusOK, result)
+	case action == "checkin" && f.checkedOut[target]:
+		delete(f.checkedOut, target)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		fakeBastionError(w, http.StatusBadRequest, "target account "+target+" is not checked out")
+	}
+}
+
+// targetCredentials returns the credentials collection of a target account,
+// empty if the account doesn't exist.
+func (f *fakeBastion) targetCredentials(target string) string {
+	parts := strings.Split(target, "@")
+	var accounts string
+	switch len(parts) {
+	case 2:
+		domain, idx := f.find("/domains/", parts[1])
+		if idx < 0 {
+			return ""
+		}
+		accounts = fmt.Sprintf("/domains/%v/accounts/", domain["id"])
+	case 3:
+		for _, parents := range []string{"/devices/", "/applications/"} {
+			parent, idx := f.find(parents, parts[2])
+			if idx < 0 {
+				continue
+			}
+			localDomains := fmt.Sprintf("%s%v/localdomains/", parents, parent["id"])
+			if domain, idx := f.find(localDomains, parts[1]); idx >= 0 {
+				accounts = fmt.Sprintf("%s%v/accounts/", localDomains, domain["id"])
+			}
+		}
+	}
+	account, idx := f.find(accounts, parts[0])
+	if accounts == "" || idx < 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s%v/credentials/", accounts, account["id"])
+}
+
+// find returns the object of collection with key as id or name.
+func (f *fakeBastion) find(collection, key string) (map[string]interface{}, int) {
+	nameField := fakeBastionNameFields[path.Base(collection)]
+	for i, v := range f.collections[collection] {
+		if fmt.Sprint(v["id"]) == key || (nameField != "" && fmt.Sprint(v[nameField]) == key) {
+			return v, i
+		}
+	}
+
+	return nil, -1
+}
+
+// fakeBastionMatch reports whether object matches all the field=value
+// criteria of query separated by &&, ignoring case like the bastion. A list
+// field matches when it contains the value.
+func fakeBastionMatch(object map[string]interface{}, query string) bool {
+	if query == "" {
+		return true
+	}
+	for _, criterion := range bastion.SplitTestSearchQuery(query) {
+		field, value := criterion[0], criterion[1]
+		switch v := object[field].(type) {
+		case []interface{}:
+			if !slices.ContainsFunc(v, func(e interface{}) bool { return strings.EqualFold(fmt.Sprint(e), value) }) {
+				return false
+			}
+		default:
+			if !strings.EqualFold(fmt.Sprint(v), value) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// fakeBastionSort sorts objects by the comma-separated fields of sort,
+// descending for a field with a - prefix.
+func fakeBastionSort(objects []map[string]interface{}, sort string) {
+	if sort == "" {
+		return
+	}
+	slices.SortStableFunc(objects, func(a, b map[string]interface{}) int {
+		for _, field := range strings.Split(sort, ",") {
+			desc := strings.HasPrefix(field, "-")
+			field = strings.TrimPrefix(field, "-")
+			cmp := strings.Compare(fmt.Sprint(a[field]), fmt.Sprint(b[field]))
+			if desc {
+				cmp = -cmp
+			}
+			if cmp != 0 {
+				return cmp
+			}
+		}
+
+		return 0
+	})
+}
+
+// embed returns a copy of object with its sub-collections inline, without
+// its write-only fields.
+func (f *fakeBastion) embed(collection string, object map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(object))
+	for k, v := range object {
+		if !slices.Contains(fakeBastionWriteOnly[path.Base(collection)], k) {
+			result[k] = v
+		}
+	}
+	for field, sub := range fakeBastionEmbeds[path.Base(collection)] {
+		subCollection := collection + fmt.Sprint(object["id"]) + "/" + sub + "/"
+		list := make([]map[string]interface{}, 0, len(f.collections[subCollection]))
+		for _, v := range f.collections[subCollection] {
+			list = append(list, f.embed(subCollection, v))
+		}
+		result[field] = list
+	}
+
+	return result
+}
+
+// fakeBastionPublicKey sets the public key that the API derives from the
+// private key of an SSH key credential.
+func fakeBastionPublicKey(collection string, object map[string]interface{}) {
+	privateKey, ok := object["private_key"].(string)
+	if path.Base(collection) != "credentials" || object["type"] != "ssh_key" || !ok {
+		return
+	}
+	sum := sha256.Sum256([]byte(privateKey))
+	object["public_key"] = "ssh-ed25519 " + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func fakeBastionWrite(w http.ResponseWriter, code int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func fakeBastionError(w http.ResponseWriter, code int, description string) {
+	fakeBastionWrite(w, code, map[string]interface{}{
+		"error":       http.StatusText(code),
+		"description": description,
+	})
+}
+
+// testAccCheckFakeBastionField checks the value of field last sent by the
+// provider for the object with key as id or name in collection, which only
+// the fake bastion reports.
+func testAccCheckFakeBastionField(collection, key, field, value string) resource.TestCheckFunc {
+	return testAccCheckFakeBastionObjectField(func(*fakeBastion) string { return collection }, key, field, value)
+}
+
+// testAccCheckFakeBastionCredential is testAccCheckFakeBastionField on the
+// credential with credentialType of a target account like account@domain.
+func testAccCheckFakeBastionCredential(target, credentialType, field, value string) resource.TestCheckFunc {
+	return testAccCheckFakeBastionObjectField(func(f *fakeBastion) string {
+		return f.targetCredentials(target)
+	}, credentialType, field, value)
+}
+
+func testAccCheckFakeBastionObjectField(
+	collection func(*fakeBastion) string, key, field, value string,
+) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if testAccFakeBastion == nil {
+			return nil
+		}
+		testAccFakeBastion.mutex.Lock()
+		defer testAccFakeBastion.mutex.Unlock()
+		object, idx := testAccFakeBastion.find(collection(testAccFakeBastion), key)
+		if idx < 0 {
+			return fmt.Errorf("object %s not found in the fake bastion", key)
+		}
+		if got := fmt.Sprint(object[field]); got != value {
+			return fmt.Errorf("field %s of object %s: expected %q, got %q", field, key, value, got)
+		}
+
+		return nil
+	}
+}
+
+// testAccFakeBastionSetCredential changes field of the credential with
+// credentialType of a target account outside of Terraform, like a rotation by
+// the bastion.
+func testAccFakeBastionSetCredential(t *testing.T, target, credentialType, field, value string) func() {
+	t.Helper()
+
+	return func() {
+		testAccFakeBastion.mutex.Lock()
+		defer testAccFakeBastion.mutex.Unlock()
+		object, idx := testAccFakeBastion.find(testAccFakeBastion.targetCredentials(target), credentialType)
+		if idx < 0 {
+			t.Fatalf("credential %s of %s not found in the fake bastion", credentialType, target)
+		}
+		object[field] = value
+	}
+}
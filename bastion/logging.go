@@ -0,0 +1,175 @@
+package bastion
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// logSubsystem is the tflog subsystem of the requests to the bastion API,
+	// its level can be set apart with TF_LOG_PROVIDER_WALLIX_BASTION_API.
+	logSubsystem    = "wallix_bastion_api"
+	logSubsystemEnv = "TF_LOG_PROVIDER_WALLIX_BASTION_API"
+	logRedacted     = "***"
+)
+
+// logSensitiveKeys are the JSON keys and headers whose values are masked in
+// logs. A JSON key also matches when it ends with one of them after an
+// underscore, like server_private_key.
+func logSensitiveKeys() []string {
+	return []string{
+		"password",
+		"private_key",
+		"passphrase",
+		"ssh_key",
+		"secret",
+		"client_secret",
+		"ca_private_key",
+		"x-auth-key",
+		"authorization",
+		"cookie",
+		"set-cookie",
+	}
+}
+
+func isLogSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, v := range logSensitiveKeys() {
+		if key == v || strings.HasSuffix(key, "_"+v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withLogSubsystem returns ctx with the tflog subsystem of the bastion API
+// and a new request_id field to match a request with its retries and
+// responses in logs.
+func withLogSubsystem(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv(logSubsystemEnv))
+
+	return tflog.SubsystemSetField(ctx, logSubsystem, "request_id", newLogRequestID())
+}
+
+func newLogRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// logRedactBody returns body with the values of sensitive keys masked.
+// A body which isn't JSON is returned as is.
+func logRedactBody(body []byte) string {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return string(body)
+	}
+	if data == nil {
+		return ""
+	}
+	redacted, err := json.Marshal(logRedactValue(data))
+	if err != nil {
+		return ""
+	}
+
+	return string(redacted)
+}
+
+func logRedactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, sub := range v {
+			if isLogSensitiveKey(key) {
+				if sub != nil && sub != "" {
+					v[key] = logRedacted
+				}
+
+				continue
+			}
+			v[key] = logRedactValue(sub)
+		}
+	case []interface{}:
+		for i, sub := range v {
+			v[i] = logRedactValue(sub)
+		}
+	}
+
+	return value
+}
+
+// logRedactHeader returns header as log fields with the values of sensitive
+// headers masked.
+func logRedactHeader(header http.Header) map[string]interface{} {
+	result := make(map[string]interface{}, len(header))
+	for key, values := range header {
+		if isLogSensitiveKey(key) {
+			result[key] = logRedacted
+
+			continue
+		}
+		result[key] = strings.Join(values, ", ")
+	}
+
+	return result
+}
+
+func logRequest(ctx context.Context, req *http.Request, body []byte) {
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.RequestURI(),
+		"headers": logRedactHeader(req.Header),
+	}
+	if redacted := logRedactBody(body); redacted != "" {
+		fields["body"] = redacted
+	}
+	tflog.SubsystemDebug(ctx, logSubsystem, "sending bastion API request", fields)
+}
+
+func logResponse(
+	ctx context.Context, req *http.Request, resp *http.Response, body []byte, duration time.Duration,
+) {
+	fields := map[string]interface{}{
+		"method":      req.Method,
+		"path":        req.URL.RequestURI(),
+		"status":      resp.StatusCode,
+		"duration_ms": duration.Milliseconds(),
+		"headers":     logRedactHeader(resp.Header),
+	}
+	if redacted := logRedactBody(body); redacted != "" {
+		fields["body"] = redacted
+	}
+	tflog.SubsystemDebug(ctx, logSubsystem, "received bastion API response", fields)
+}
+
+func logRequestError(ctx context.Context, req *http.Request, err error, duration time.Duration) {
+	tflog.SubsystemWarn(ctx, logSubsystem, "bastion API request failed", map[string]interface{}{
+		"method":      req.Method,
+		"path":        req.URL.RequestURI(),
+		"duration_ms": duration.Milliseconds(),
+		"error":       err.Error(),
+	})
+}
+
+// logRetry records why a request is sent again and after how long.
+func logRetry(ctx context.Context, method, path string, code int, err error, wait time.Duration) {
+	fields := map[string]interface{}{
+		"method":  method,
+		"path":    path,
+		"wait_ms": wait.Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["status"] = code
+	}
+	tflog.SubsystemInfo(ctx, logSubsystem, "retrying bastion API request", fields)
+}
@@ -0,0 +1,133 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// nullCollectionsServer serves the resources of terraform-plugin-sdk and keeps
+// the collections that are null in the prior state null when the read of a
+// resource sets them empty.
+// terraform-plugin-sdk saves a collection planned as null as null after an
+// apply, even when the resource sets it empty, so without it the next refresh
+// reports a change from null to empty for each of them.
+type nullCollectionsServer struct {
+	tfprotov5.ProviderServer
+
+	mutex         sync.Mutex
+	resourceTypes map[string]tftypes.Type
+}
+
+func newNullCollectionsServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return &nullCollectionsServer{ProviderServer: server}
+}
+
+func (s *nullCollectionsServer) ReadResource(
+	ctx context.Context, req *tfprotov5.ReadResourceRequest,
+) (
+	*tfprotov5.ReadResourceResponse, error,
+) {
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if err != nil || resp.NewState == nil || req.CurrentState == nil {
+		return resp, err
+	}
+	resourceType, err := s.resourceType(ctx, req.TypeName)
+	if err == nil {
+		resp.NewState, err = keepNullCollections(resourceType, req.CurrentState, resp.NewState)
+	}
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "keeping null collections of " + req.TypeName,
+			Detail:   err.Error(),
+		})
+	}
+
+	return resp, nil
+}
+
+// resourceType returns the type of the state of the resource typeName.
+func (s *nullCollectionsServer) resourceType(ctx context.Context, typeName string) (tftypes.Type, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.resourceTypes == nil {
+		resp, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("reading provider schema: %w", err)
+		}
+		s.resourceTypes = make(map[string]tftypes.Type, len(resp.ResourceSchemas))
+		for k, v := range resp.ResourceSchemas {
+			s.resourceTypes[k] = v.ValueType()
+		}
+	}
+	resourceType, ok := s.resourceTypes[typeName]
+	if !ok {
+		return nil, fmt.Errorf("resource %s not found in provider schema", typeName)
+	}
+
+	return resourceType, nil
+}
+
+// keepNullCollections returns the state newState with the empty collections
+// that are null in priorState set to null.
+func keepNullCollections(
+	resourceType tftypes.Type, priorState, newState *tfprotov5.DynamicValue,
+) (
+	*tfprotov5.DynamicValue, error,
+) {
+	priorValue, err := priorState.Unmarshal(resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("decoding prior state: %w", err)
+	}
+	newValue, err := newState.Unmarshal(resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("decoding new state: %w", err)
+	}
+	if priorValue.IsNull() || newValue.IsNull() {
+		return newState, nil
+	}
+	result, err := tftypes.Transform(newValue, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !isEmptyCollection(v) {
+			return v, nil
+		}
+		// a path missing from the prior state, like a new element, keeps v
+		if prior, _, err := tftypes.WalkAttributePath(priorValue, p); err == nil {
+			if prior, ok := prior.(tftypes.Value); ok && prior.IsNull() {
+				return tftypes.NewValue(v.Type(), nil), nil
+			}
+		}
+
+		return v, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transforming new state: %w", err)
+	}
+	resultState, err := tfprotov5.NewDynamicValue(resourceType, result)
+	if err != nil {
+		return nil, fmt.Errorf("encoding new state: %w", err)
+	}
+
+	return &resultState, nil
+}
+
+func isEmptyCollection(v tftypes.Value) bool {
+	if v.IsNull() || !v.IsKnown() {
+		return false
+	}
+	switch {
+	case v.Type().Is(tftypes.List{}), v.Type().Is(tftypes.Set{}):
+		var elements []tftypes.Value
+
+		return v.As(&elements) == nil && len(elements) == 0
+	case v.Type().Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+
+		return v.As(&elements) == nil && len(elements) == 0
+	}
+
+	return false
+}
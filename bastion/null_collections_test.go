@@ -0,0 +1,82 @@
+package bastion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNullCollectionsServerReadResource(t *testing.T) {
+	sdkProvider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"test_resource": {
+				Schema: map[string]*schema.Schema{
+					"list": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					"set":  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					"map":  {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					"read": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+				ReadContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+					for _, k := range []string{"list", "set", "map"} {
+						if tfErr := d.Set(k, nil); tfErr != nil {
+							panic(tfErr)
+						}
+					}
+					if tfErr := d.Set("read", []string{"a"}); tfErr != nil {
+						panic(tfErr)
+					}
+
+					return nil
+				},
+			},
+		},
+	}
+	server := newNullCollectionsServer(sdkProvider.GRPCProvider())
+	resourceType, err := server.(*nullCollectionsServer).resourceType(context.Background(), "test_resource")
+	if err != nil {
+		t.Fatal(err)
+	}
+	attributeTypes := resourceType.(tftypes.Object).AttributeTypes
+	priorState, err := tfprotov5.NewDynamicValue(resourceType, tftypes.NewValue(resourceType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "1"),
+		"list": tftypes.NewValue(attributeTypes["list"], nil),
+		"set":  tftypes.NewValue(attributeTypes["set"], []tftypes.Value{}),
+		"map":  tftypes.NewValue(attributeTypes["map"], nil),
+		"read": tftypes.NewValue(attributeTypes["read"], nil),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{
+		TypeName:     "test_resource",
+		CurrentState: &priorState,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics[0])
+	}
+	newValue, err := resp.NewState.Unmarshal(resourceType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := newValue.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	// null before the read stays null, empty stays empty
+	if !attributes["list"].IsNull() || !attributes["map"].IsNull() {
+		t.Errorf("null collections set empty: list %s, map %s", attributes["list"], attributes["map"])
+	}
+	if attributes["set"].IsNull() {
+		t.Errorf("empty set set null")
+	}
+	if attributes["read"].IsNull() {
+		t.Errorf("collection read with a value set null")
+	}
+}
@@ -2,9 +2,14 @@ package bastion
 
 import (
 	"context"
+	"os"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -21,18 +26,32 @@ func defaultVersionsValid() []string {
 
 // Provider wallix-bastion for terraform.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"ip": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("WALLIX_BASTION_HOST", nil),
 			},
+			"hosts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
 			"user": {
 				Type:        schema.TypeString,
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("WALLIX_BASTION_USER", nil),
 			},
+			"scheme": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("WALLIX_BASTION_SCHEME", "https"),
+				ValidateFunc: validation.StringInSlice([]string{"https", "http"}, false),
+			},
 			"port": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -52,19 +71,120 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("WALLIX_BASTION_API_VERSION", VersionWallixAPI38),
+				ValidateFunc: validation.StringInSlice(
+					append(defaultVersionsValid(), VersionWallixAPIAuto), false,
+				),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("WALLIX_BASTION_MAX_RETRIES", defaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("WALLIX_BASTION_RETRY_MAX_WAIT", int(defaultRetryMaxWait.Seconds())),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"auth_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("WALLIX_BASTION_AUTH_MODE", AuthModeBasic),
+				ValidateFunc: validation.StringInSlice(defaultAuthModesValid(), false),
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("WALLIX_BASTION_CA_CERT_FILE", nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_file"},
+			},
+			"server_cert_fingerprint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WALLIX_BASTION_SERVER_CERT_FINGERPRINT", nil),
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9a-fA-F]{2}:?){31}[0-9a-fA-F]{2}$`),
+					"must be a SHA-256 hex digest"),
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WALLIX_BASTION_INSECURE_SKIP_VERIFY", false),
+			},
+			"client_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WALLIX_BASTION_CLIENT_CERT_PEM", nil),
+			},
+			"client_key_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("WALLIX_BASTION_CLIENT_KEY_PEM", nil),
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WALLIX_BASTION_PROXY_URL", nil),
+			},
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WALLIX_BASTION_NO_PROXY", nil),
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"wallix-bastion_configoption":          dataSourceConfigoption(),
-			"wallix-bastion_domain":                dataSourceDomain(),
-			"wallix-bastion_local_password_policy": dataSourceLocalPasswordPolicy(),
-			"wallix-bastion_version":               dataSourceVersion(),
-			"wallix-bastion_authdomain_ad":         dataSourceAuthDomainAD(),
+			"wallix-bastion_configoption":                     dataSourceConfigoption(),
+			"wallix-bastion_device":                           dataSourceDevice(),
+			"wallix-bastion_devices":                          dataSourceDevices(),
+			"wallix-bastion_domain":                           dataSourceDomain(),
+			"wallix-bastion_local_password_policy":            dataSourceLocalPasswordPolicy(),
+			"wallix-bastion_user":                             dataSourceUser(),
+			"wallix-bastion_users":                            dataSourceUsers(),
+			"wallix-bastion_version":                          dataSourceVersion(),
+			"wallix-bastion_approvals":                        dataSourceApprovals(),
+			"wallix-bastion_authdomain_ad":                    dataSourceAuthDomainAD(),
+			"wallix-bastion_authdomain_azuread":               dataSourceAuthDomainAzureAD(),
+			"wallix-bastion_authdomain_ldap":                  dataSourceAuthDomainLdap(),
+			"wallix-bastion_authdomain_saml":                  dataSourceAuthDomainSAML(),
+			"wallix-bastion_external_auths":                   dataSourceExternalAuths(),
+			"wallix-bastion_externalauth_kerberos":            dataSourceExternalAuthKerberos(),
+			"wallix-bastion_externalauth_ldap":                dataSourceExternalAuthLdap(),
+			"wallix-bastion_externalauth_radius":              dataSourceExternalAuthRadius(),
+			"wallix-bastion_externalauth_saml":                dataSourceExternalAuthSaml(),
+			"wallix-bastion_externalauth_tacacs":              dataSourceExternalAuthTacacs(),
+			"wallix-bastion_authorization":                    dataSourceAuthorization(),
+			"wallix-bastion_authorizations":                   dataSourceAuthorizations(),
+			"wallix-bastion_targetgroup":                      dataSourceTargetGroup(),
+			"wallix-bastion_targetgroups":                     dataSourceTargetGroups(),
+			"wallix-bastion_usergroup":                        dataSourceUserGroup(),
+			"wallix-bastion_usergroups":                       dataSourceUserGroups(),
+			"wallix-bastion_password_change_policy":           dataSourcePasswordChangePolicy(),
+			"wallix-bastion_profile":                          dataSourceProfile(),
+			"wallix-bastion_profiles":                         dataSourceProfiles(),
+			"wallix-bastion_timeframe":                        dataSourceTimeframe(),
+			"wallix-bastion_timeframes":                       dataSourceTimeframes(),
+			"wallix-bastion_connection_policy":                dataSourceConnectionPolicy(),
+			"wallix-bastion_connection_policies":              dataSourceConnectionPolicies(),
+			"wallix-bastion_checkout_policy":                  dataSourceCheckoutPolicy(),
+			"wallix-bastion_checkout_policies":                dataSourceCheckoutPolicies(),
+			"wallix-bastion_domain_account":                   dataSourceDomainAccount(),
+			"wallix-bastion_domain_accounts":                  dataSourceDomainAccounts(),
+			"wallix-bastion_device_localdomain_account":       dataSourceDeviceLocalDomainAccount(),
+			"wallix-bastion_device_localdomain_accounts":      dataSourceDeviceLocalDomainAccounts(),
+			"wallix-bastion_application_localdomain_account":  dataSourceApplicationLocalDomainAccount(),
+			"wallix-bastion_application_localdomain_accounts": dataSourceApplicationLocalDomainAccounts(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"wallix-bastion_application":                           resourceApplication(),
 			"wallix-bastion_application_localdomain":               resourceApplicationLocalDomain(),
 			"wallix-bastion_application_localdomain_account":       resourceApplicationLocalDomainAccount(),
+			"wallix-bastion_approval_response":                     resourceApprovalResponse(),
 			"wallix-bastion_authdomain_ad":                         resourceAuthDomainAD(),
 			"wallix-bastion_authdomain_azuread":                    resourceAuthDomainAzureAD(),
 			"wallix-bastion_authdomain_ldap":                       resourceAuthDomainLdap(),
@@ -90,29 +210,65 @@ func Provider() *schema.Provider {
 			"wallix-bastion_externalauth_saml":                     resourceExternalAuthSaml(),
 			"wallix-bastion_externalauth_tacacs":                   resourceExternalAuthTacacs(),
 			"wallix-bastion_encryption":                            resourceEncryption(),
+			"wallix-bastion_local_password_policy":                 resourceLocalPasswordPolicy(),
+			"wallix-bastion_password_change_policy":                resourcePasswordChangePolicy(),
 			"wallix-bastion_profile":                               resourceProfile(),
 			"wallix-bastion_targetgroup":                           resourceTargetGroup(),
 			"wallix-bastion_timeframe":                             resourceTimeframe(),
-			"wallix-bastion_user":                                  resourceUser(),
 			"wallix-bastion_usergroup":                             resourceUserGroup(),
 		},
 		ConfigureContextFunc: configureProvider,
 	}
+	for _, v := range provider.DataSourcesMap {
+		withFailoverDiagnostics(v)
+	}
+	for _, v := range provider.ResourcesMap {
+		withFailoverDiagnostics(withTimeouts(v))
+	}
+
+	return provider
 }
 
 func configureProvider(
-	_ context.Context, d *schema.ResourceData,
+	ctx context.Context, d *schema.ResourceData,
 ) (
 	interface{}, diag.Diagnostics,
 ) {
+	var hosts []string
+	for _, v := range d.Get("hosts").([]interface{}) {
+		hosts = append(hosts, v.(string))
+	}
+	if len(hosts) == 0 && os.Getenv("WALLIX_BASTION_HOSTS") != "" {
+		for _, v := range strings.Split(os.Getenv("WALLIX_BASTION_HOSTS"), ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				hosts = append(hosts, v)
+			}
+		}
+	}
+	if len(hosts) == 0 && d.Get("ip").(string) == "" {
+		return nil, diag.Errorf("one of ip or hosts must be set")
+	}
 	config := Config{
-		bastionAPIVersion: d.Get("api_version").(string),
-		bastionIP:         d.Get("ip").(string),
-		bastionPort:       d.Get("port").(int),
-		bastionToken:      d.Get("token").(string),
-		bastionUser:       d.Get("user").(string),
-		bastionPwd:        d.Get("password").(string),
+		bastionAPIVersion:     d.Get("api_version").(string),
+		bastionIP:             d.Get("ip").(string),
+		bastionHosts:          hosts,
+		bastionScheme:         d.Get("scheme").(string),
+		bastionPort:           d.Get("port").(int),
+		bastionToken:          d.Get("token").(string),
+		bastionUser:           d.Get("user").(string),
+		bastionPwd:            d.Get("password").(string),
+		maxRetries:            d.Get("max_retries").(int),
+		retryMaxWait:          time.Duration(d.Get("retry_max_wait").(int)) * time.Second,
+		caCertFile:            d.Get("ca_cert_file").(string),
+		caCertPEM:             d.Get("ca_cert_pem").(string),
+		serverCertFingerprint: d.Get("server_cert_fingerprint").(string),
+		insecureSkipVerify:    d.Get("insecure_skip_verify").(bool),
+		clientCertPEM:         d.Get("client_cert_pem").(string),
+		clientKeyPEM:          d.Get("client_key_pem").(string),
+		proxyURL:              d.Get("proxy_url").(string),
+		noProxy:               d.Get("no_proxy").(string),
+		authMode:              d.Get("auth_mode").(string),
 	}
 
-	return config.Client()
+	return config.Client(ctx)
 }
@@ -0,0 +1,162 @@
+package bastion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderServerFactory returns the server of the provider, which muxes the
+// resources of terraform-plugin-sdk with the ones of
+// terraform-plugin-framework, like ephemeral resources.
+func ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		// the sdk provider needs to be first to be configured before the framework one
+		func() tfprotov5.ProviderServer { return newNullCollectionsServer(sdkProvider.GRPCProvider()) },
+		providerserver.NewProtocol5(newFrameworkProvider(sdkProvider)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("muxing provider servers: %w", err)
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProvider serves the terraform-plugin-framework part of the
+// provider. It shares the schema and the client of the sdk provider.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+func newFrameworkProvider(sdkProvider *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+var _ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+
+func (p *frameworkProvider) Metadata(
+	_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse,
+) {
+	resp.TypeName = "wallix-bastion"
+}
+
+func (p *frameworkProvider) Schema(
+	ctx context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse,
+) {
+	// muxed servers need the same provider schema, so it is the one of the sdk
+	sdkSchema, err := p.sdkProvider.GRPCProvider().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("reading sdk provider schema", err.Error())
+
+		return
+	}
+	attributes := make(map[string]providerschema.Attribute, len(sdkSchema.Provider.Block.Attributes))
+	for _, v := range sdkSchema.Provider.Block.Attributes {
+		attribute, err := frameworkProviderAttribute(v)
+		if err != nil {
+			resp.Diagnostics.AddError("converting sdk provider schema", err.Error())
+
+			return
+		}
+		attributes[v.Name] = attribute
+	}
+	resp.Schema = providerschema.Schema{Attributes: attributes}
+}
+
+func frameworkProviderAttribute(attribute *tfprotov5.SchemaAttribute) (providerschema.Attribute, error) {
+	switch {
+	case attribute.Type.Is(tftypes.String):
+		return providerschema.StringAttribute{
+			Required:    attribute.Required,
+			Optional:    attribute.Optional,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}, nil
+	case attribute.Type.Is(tftypes.Number):
+		return providerschema.Int64Attribute{
+			Required:    attribute.Required,
+			Optional:    attribute.Optional,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}, nil
+	case attribute.Type.Is(tftypes.Bool):
+		return providerschema.BoolAttribute{
+			Required:    attribute.Required,
+			Optional:    attribute.Optional,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}, nil
+	case attribute.Type.Is(tftypes.List{ElementType: tftypes.String}):
+		return providerschema.ListAttribute{
+			ElementType: types.StringType,
+			Required:    attribute.Required,
+			Optional:    attribute.Optional,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}, nil
+	default:
+		return nil, fmt.Errorf("type %s of provider argument %s not supported", attribute.Type, attribute.Name)
+	}
+}
+
+// Configure reuses the client of the sdk provider, configured before by the
+// mux server with the same configuration.
+func (p *frameworkProvider) Configure(
+	_ context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse,
+) {
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+	c, ok := p.sdkProvider.Meta().(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("provider not configured", "the client of the provider is not available")
+
+		return
+	}
+	resp.EphemeralResourceData = c
+	resp.ResourceData = c
+}
+
+// Resources returns the resources migrated from terraform-plugin-sdk, removed
+// from the ResourcesMap of Provider.
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newResourceUser,
+	}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralAccountCheckout,
+	}
+}
+
+// frameworkClient returns the client given by the provider to the Configure
+// method of a resource, nil while the provider is not configured.
+func frameworkClient(providerData interface{}) (*Client, error) {
+	if providerData == nil {
+		return nil, nil //nolint: nilnil
+	}
+	c, ok := providerData.(*Client)
+	if !ok {
+		return nil, fmt.Errorf("unexpected provider data %T", providerData)
+	}
+
+	return c, nil
+}
@@ -2,13 +2,21 @@ package bastion_test
 
 import (
 	"context"
+	"encoding/json"
+	"net/url"
 	"os"
+	"os/exec"
+	"slices"
+	"sync"
 	"testing"
 
 	"github.com/wallix/terraform-provider-wallix-bastion/bastion"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"golang.org/x/mod/semver"
 )
 
 var (
@@ -16,6 +24,33 @@ var (
 		"wallix-bastion": testAccProvider,
 	}
 	testAccProvider = bastion.Provider() //nolint: gochecknoglobals
+
+	// testAccProtoV5ProviderFactories serves the provider with the muxed
+	// server, needed by the resources of terraform-plugin-framework.
+	testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){ //nolint: gochecknoglobals
+		"wallix-bastion": func() (tfprotov5.ProviderServer, error) {
+			serverFactory, err := bastion.ProviderServerFactory(context.Background())
+			if err != nil {
+				return nil, err
+			}
+
+			return serverFactory(), nil
+		},
+	}
+
+	// testAccSDKProviders is the last release of the provider with all its
+	// resources on terraform-plugin-sdk, to create the states that resources
+	// migrated to terraform-plugin-framework need to read.
+	testAccSDKProviders = map[string]resource.ExternalProvider{ //nolint: gochecknoglobals
+		"wallix-bastion": {
+			Source:            "wallix/wallix-bastion",
+			VersionConstraint: "0.14.6",
+		},
+	}
+
+	testAccFakeBastion        *fakeBastion //nolint: gochecknoglobals
+	testAccFakeBastionOnce    sync.Once    //nolint: gochecknoglobals
+	testAccFakeBastionTLSOnce sync.Once    //nolint: gochecknoglobals
 )
 
 func TestProvider(t *testing.T) {
@@ -28,10 +63,39 @@ func TestProvider_impl(_ *testing.T) {
 	_ = bastion.Provider()
 }
 
+func TestProviderServerFactory(t *testing.T) {
+	serverFactory, err := bastion.ProviderServerFactory(context.Background())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp, err := serverFactory().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, v := range resp.Diagnostics {
+		t.Errorf("%s: %s", v.Summary, v.Detail)
+	}
+	if _, ok := resp.EphemeralResourceSchemas["wallix-bastion_account_checkout"]; !ok {
+		t.Errorf("ephemeral resource wallix-bastion_account_checkout not served")
+	}
+	if _, ok := resp.ResourceSchemas["wallix-bastion_user"]; !ok {
+		t.Errorf("resource wallix-bastion_user not served")
+	}
+	for name, resourceSchema := range resp.ResourceSchemas {
+		if !slices.ContainsFunc(resourceSchema.Block.BlockTypes, func(b *tfprotov5.SchemaNestedBlock) bool {
+			return b.TypeName == "timeouts"
+		}) {
+			t.Errorf("resource %s without timeouts block", name)
+		}
+	}
+}
+
+// testAccPreCheck checks the environment to reach the bastion under test.
+// Without WALLIX_BASTION_HOST, tests run against an in-memory fake bastion.
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 	if os.Getenv("WALLIX_BASTION_HOST") == "" {
-		t.Fatal("WALLIX_BASTION_HOST must be set for acceptance tests")
+		testAccUseFakeBastion(t)
 	}
 	if os.Getenv("WALLIX_BASTION_TOKEN") == "" {
 		t.Fatal("WALLIX_BASTION_TOKEN must be set for acceptance tests")
@@ -44,3 +108,64 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// testAccUseFakeBastion points the provider at the fake bastion,
+// shared by all tests of the package.
+func testAccUseFakeBastion(t *testing.T) {
+	t.Helper()
+	testAccFakeBastionOnce.Do(func() {
+		testAccFakeBastion = newFakeBastion()
+	})
+	u, err := url.Parse(testAccFakeBastion.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("WALLIX_BASTION_SCHEME", u.Scheme)
+	t.Setenv("WALLIX_BASTION_HOST", u.Hostname())
+	t.Setenv("WALLIX_BASTION_PORT", u.Port())
+	t.Setenv("WALLIX_BASTION_USER", "admin")
+	t.Setenv("WALLIX_BASTION_TOKEN", "fake")
+}
+
+// testAccPreCheckSDKProvider is testAccPreCheck for the tests which use
+// testAccSDKProviders. The released provider only talks https, so the fake
+// bastion is reached over TLS, with its self-signed certificate accepted.
+func testAccPreCheckSDKProvider(t *testing.T) {
+	t.Helper()
+	useFakeBastion := os.Getenv("WALLIX_BASTION_HOST") == ""
+	testAccPreCheck(t)
+	if !useFakeBastion {
+		return
+	}
+	testAccFakeBastionTLSOnce.Do(testAccFakeBastion.startTLS)
+	u, err := url.Parse(testAccFakeBastion.tlsServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("WALLIX_BASTION_SCHEME", u.Scheme)
+	t.Setenv("WALLIX_BASTION_PORT", u.Port())
+	t.Setenv("WALLIX_BASTION_INSECURE_SKIP_VERIFY", "true")
+}
+
+// testAccPreCheckTerraformVersion skips the test when the terraform binary
+// of the acceptance tests is older than minVersion (like v1.10.0).
+func testAccPreCheckTerraformVersion(t *testing.T, minVersion string) {
+	t.Helper()
+	terraformPath := os.Getenv("TF_ACC_TERRAFORM_PATH")
+	if terraformPath == "" {
+		terraformPath = "terraform"
+	}
+	out, err := exec.Command(terraformPath, "version", "-json").Output()
+	if err != nil {
+		t.Fatalf("reading terraform version: %s", err)
+	}
+	var version struct {
+		TerraformVersion string `json:"terraform_version"`
+	}
+	if err := json.Unmarshal(out, &version); err != nil {
+		t.Fatalf("reading terraform version: %s", err)
+	}
+	if semver.Compare("v"+version.TerraformVersion, minVersion) < 0 {
+		t.Skipf("terraform %s is older than %s", version.TerraformVersion, minVersion)
+	}
+}
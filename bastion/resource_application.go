@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"golang.org/x/mod/semver"
 )
 
 type jsonApplication struct {
@@ -45,6 +44,7 @@ func resourceApplication() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceApplicationImport,
 		},
+		CustomizeDiff: resourceApplicationCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"application_name": {
 				Type:     schema.TypeString,
@@ -163,30 +163,44 @@ func resourceApplicationVersionCheck(version string) error {
 	return fmt.Errorf("resource wallix-bastion_application not available with api version %s", version)
 }
 
+func resourceApplicationCustomizeDiff(
+	_ context.Context, d *schema.ResourceDiff, m interface{},
+) error {
+	c, ok := m.(*Client)
+	if !ok {
+		return nil
+	}
+	if d.Get("category").(string) == "jumphost" {
+		return c.checkCapability(capabilityApplicationCategory, "category = jumphost")
+	}
+
+	return nil
+}
+
 func resourceApplicationCreate(
 	ctx context.Context, d *schema.ResourceData, m interface{},
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceApplicationVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceApplication(ctx, d.Get("application_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("application_name %s already exists", d.Get("application_name").(string)))
+		return diagFromErr(fmt.Errorf("application_name %s already exists", d.Get("application_name").(string)))
 	}
-	err = addApplication(ctx, d, m, c.bastionAPIVersion)
+	err = addApplication(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceApplication(ctx, d.Get("application_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("application_name %s not found after POST", d.Get("application_name").(string)))
+		return diagFromErr(fmt.Errorf("application_name %s not found after POST", d.Get("application_name").(string)))
 	}
 	d.SetId(id)
 
@@ -198,11 +212,11 @@ func resourceApplicationRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceApplicationVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readApplicationOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -219,10 +233,10 @@ func resourceApplicationUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceApplicationVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
-	if err := updateApplication(ctx, d, m, c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+	if err := updateApplication(ctx, d, m); err != nil {
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -234,10 +248,10 @@ func resourceApplicationDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceApplicationVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteApplication(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -277,31 +291,14 @@ func searchResourceApplication(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/applications/?q=application_name="+applicationName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonApplication
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/applications/", "application_name", applicationName, m)
 }
 
 func addApplication(
-	ctx context.Context, d *schema.ResourceData, m interface{}, apiVersion string,
+	ctx context.Context, d *schema.ResourceData, m interface{},
 ) error {
 	c := m.(*Client)
-	jsonData, err := prepareApplicationJSON(d, true, apiVersion)
+	jsonData, err := prepareApplicationJSON(d, true, c)
 	if err != nil {
 		return err
 	}
@@ -310,17 +307,17 @@ func addApplication(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
 }
 
 func updateApplication(
-	ctx context.Context, d *schema.ResourceData, m interface{}, apiVersion string,
+	ctx context.Context, d *schema.ResourceData, m interface{},
 ) error {
 	c := m.(*Client)
-	jsonData, err := prepareApplicationJSON(d, false, apiVersion)
+	jsonData, err := prepareApplicationJSON(d, false, c)
 	if err != nil {
 		return err
 	}
@@ -329,7 +326,7 @@ func updateApplication(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -344,14 +341,14 @@ func deleteApplication(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
 }
 
 func prepareApplicationJSON(
-	d *schema.ResourceData, newResource bool, apiVersion string,
+	d *schema.ResourceData, newResource bool, c *Client,
 ) (
 	jsonApplication, error,
 ) {
@@ -361,8 +358,7 @@ func prepareApplicationJSON(
 		Description:      d.Get("description").(string),
 		Parameters:       d.Get("parameters").(string),
 	}
-	if newResource &&
-		semver.Compare(apiVersion, VersionWallixAPI312) >= 0 {
+	if newResource && c.hasCapability(capabilityApplicationCategory) {
 		jsonData.Category = d.Get("category").(string)
 	}
 	switch jsonData.Category {
@@ -406,8 +402,8 @@ func prepareApplicationJSON(
 		jsonData.GlobalDomains = &jsonDataGlobalDomains
 
 	case "jumphost":
-		if semver.Compare(apiVersion, VersionWallixAPI312) < 0 {
-			return jsonData, fmt.Errorf("category = jumphost not available with api version %s", apiVersion)
+		if err := c.checkCapability(capabilityApplicationCategory, "category = jumphost"); err != nil {
+			return jsonData, err
 		}
 		if d.Get("target").(string) != "" {
 			return jsonData, errors.New("target cannot be configured when category = jumphost")
@@ -449,11 +445,13 @@ func readApplicationOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
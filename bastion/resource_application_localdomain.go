@@ -92,35 +92,35 @@ func resourceApplicationLocalDomainCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceApplicationLocalDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfgApplication, err := readApplicationOptions(ctx, d.Get("application_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgApplication.ID == "" {
-		return diag.FromErr(fmt.Errorf("application with ID %s doesn't exists", d.Get("application_id").(string)))
+		return diagFromErr(fmt.Errorf("application with ID %s doesn't exists", d.Get("application_id").(string)))
 	}
 	_, ex, err := searchResourceApplicationLocalDomain(ctx,
 		d.Get("application_id").(string), d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s on application_id %s already exists",
+		return diagFromErr(fmt.Errorf("domain_name %s on application_id %s already exists",
 			d.Get("domain_name").(string), d.Get("application_id").(string)))
 	}
 	err = addApplicationLocalDomain(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceApplicationLocalDomain(ctx,
 		d.Get("application_id").(string), d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s on application_id %s not found after POST",
+		return diagFromErr(fmt.Errorf("domain_name %s on application_id %s not found after POST",
 			d.Get("domain_name").(string), d.Get("application_id").(string)))
 	}
 	d.SetId(id)
@@ -133,11 +133,11 @@ func resourceApplicationLocalDomainRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceApplicationLocalDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readApplicationLocalDomainOptions(ctx, d.Get("application_id").(string), d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -154,10 +154,10 @@ func resourceApplicationLocalDomainUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceApplicationLocalDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateApplicationLocalDomain(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -169,10 +169,10 @@ func resourceApplicationLocalDomainDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceApplicationLocalDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteApplicationLocalDomain(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -219,25 +219,7 @@ func searchResourceApplicationLocalDomain(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/applications/"+applicationID+
-		"/localdomains/?q=domain_name="+domainName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonApplicationLocalDomain
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/applications/"+applicationID+"/localdomains/", "domain_name", domainName, m)
 }
 
 func addApplicationLocalDomain(
@@ -251,7 +233,7 @@ func addApplicationLocalDomain(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -268,7 +250,7 @@ func updateApplicationLocalDomain(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -284,7 +266,7 @@ func deleteApplicationLocalDomain(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -329,11 +311,13 @@ func readApplicationLocalDomainOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -91,44 +91,44 @@ func resourceApplicationLocalDomainAccountCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceApplicationLocalDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfgApplication, err := readApplicationOptions(ctx, d.Get("application_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgApplication.ID == "" {
-		return diag.FromErr(fmt.Errorf("application with ID %s doesn't exists", d.Get("application_id").(string)))
+		return diagFromErr(fmt.Errorf("application with ID %s doesn't exists", d.Get("application_id").(string)))
 	}
 	cfgDomain, err := readApplicationLocalDomainOptions(ctx,
 		d.Get("application_id").(string), d.Get("domain_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgDomain.ID == "" {
-		return diag.FromErr(fmt.Errorf("domain_id with ID %s on application_id %s doesn't exists",
+		return diagFromErr(fmt.Errorf("domain_id with ID %s on application_id %s doesn't exists",
 			d.Get("domain_id").(string), d.Get("application_id").(string)))
 	}
 	_, ex, err := searchResourceApplicationLocalDomainAccount(ctx,
 		d.Get("application_id").(string), d.Get("domain_id").(string), d.Get("account_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("account_name %s on domain_id %s, application_id %s already exists",
+		return diagFromErr(fmt.Errorf("account_name %s on domain_id %s, application_id %s already exists",
 			d.Get("account_name").(string), d.Get("domain_id").(string), d.Get("application_id").(string)))
 	}
 	err = addApplicationLocalDomainAccount(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceApplicationLocalDomainAccount(ctx,
 		d.Get("application_id").(string), d.Get("domain_id").(string), d.Get("account_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("account_name %s on domain_id %s, application_id %s not found after POST",
+		return diagFromErr(fmt.Errorf("account_name %s on domain_id %s, application_id %s not found after POST",
 			d.Get("account_name").(string), d.Get("domain_id").(string), d.Get("application_id").(string)))
 	}
 	d.SetId(id)
@@ -141,12 +141,12 @@ func resourceApplicationLocalDomainAccountRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceApplicationLocalDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readApplicationLocalDomainAccountOptions(ctx,
 		d.Get("application_id").(string), d.Get("domain_id").(string), d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -163,10 +163,10 @@ func resourceApplicationLocalDomainAccountUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceApplicationLocalDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateApplicationLocalDomainAccount(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -178,10 +178,10 @@ func resourceApplicationLocalDomainAccountDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceApplicationLocalDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteApplicationLocalDomainAccount(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -233,25 +233,8 @@ func searchResourceApplicationLocalDomainAccount(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/applications/"+applicationID+"/localdomains/"+domainID+
-		"/accounts/?q=account_name="+accountName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonApplicationLocalDomainAccount
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx,
+		"/applications/"+applicationID+"/localdomains/"+domainID+"/accounts/", "account_name", accountName, m)
 }
 
 func addApplicationLocalDomainAccount(
@@ -266,7 +249,7 @@ func addApplicationLocalDomainAccount(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -284,7 +267,7 @@ func updateApplicationLocalDomainAccount(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -301,7 +284,7 @@ func deleteApplicationLocalDomainAccount(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -341,11 +324,13 @@ func readApplicationLocalDomainAccountOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
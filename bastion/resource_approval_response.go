@@ -0,0 +1,180 @@
+package bastion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type jsonApprovalResponse struct {
+	Answer   bool   `json:"answer"`
+	Comment  string `json:"comment"`
+	Duration *int   `json:"duration,omitempty"`
+	Timeout  *int   `json:"timeout,omitempty"`
+}
+
+// resourceApprovalResponse answers an approval request at creation.
+// An answer can't be withdrawn, so every argument forces a new resource and
+// the destruction only removes the resource from the state.
+func resourceApprovalResponse() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApprovalResponseCreate,
+		ReadContext:   resourceApprovalResponseRead,
+		DeleteContext: resourceApprovalResponseDelete,
+		Schema: map[string]*schema.Schema{
+			"approval_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"approved": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceApprovalResponseVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("resource wallix-bastion_approval_response not available with api version %s", version)
+}
+
+func resourceApprovalResponseCreate(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := resourceApprovalResponseVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	cfg, err := readApprovalOptions(ctx, d.Get("approval_id").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		return diagFromErr(fmt.Errorf("approval with ID %s doesn't exists", d.Get("approval_id").(string)))
+	}
+	if err := addApprovalResponse(ctx, d, m); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(cfg.ID)
+
+	return resourceApprovalResponseRead(ctx, d, m)
+}
+
+func resourceApprovalResponseRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := resourceApprovalResponseVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	cfg, err := readApprovalOptions(ctx, d.Id(), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		d.SetId("")
+	} else if tfErr := d.Set("status", cfg.Status); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func resourceApprovalResponseDelete(
+	_ context.Context, _ *schema.ResourceData, _ interface{},
+) diag.Diagnostics {
+	return nil
+}
+
+func addApprovalResponse(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) error {
+	c := m.(*Client)
+	jsonData := prepareApprovalResponseJSON(d)
+	body, code, err := c.newRequest(ctx, "/approvals/assignments/"+d.Get("approval_id").(string), http.MethodPut, jsonData)
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK && code != http.StatusNoContent {
+		return newAPIError(code, body)
+	}
+
+	return nil
+}
+
+func prepareApprovalResponseJSON(d *schema.ResourceData) jsonApprovalResponse {
+	jsonData := jsonApprovalResponse{
+		Answer:  d.Get("approved").(bool),
+		Comment: d.Get("comment").(string),
+	}
+	if v := d.Get("duration").(int); v != 0 {
+		jsonData.Duration = &v
+	}
+	if v := d.Get("timeout").(int); v != 0 {
+		jsonData.Timeout = &v
+	}
+
+	return jsonData
+}
+
+func readApprovalOptions(
+	ctx context.Context, approvalID string, m interface{},
+) (
+	jsonApproval, error,
+) {
+	c := m.(*Client)
+	var result jsonApproval
+	body, code, err := c.newRequest(ctx, "/approvals/"+approvalID, http.MethodGet, nil)
+	if err != nil {
+		return result, err
+	}
+	if code != http.StatusOK {
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
+	}
+	err = json.Unmarshal([]byte(body), &result)
+	if err != nil {
+		return result, fmt.Errorf("unmarshaling json: %w", err)
+	}
+
+	return result, nil
+}
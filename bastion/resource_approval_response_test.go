@@ -0,0 +1,86 @@
+package bastion_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceApprovalResponse_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if testAccFakeBastion == nil {
+				t.Skip("approvals are requested by user sessions, only the fake bastion has some")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApprovalResponseCreate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"wallix-bastion_approval_response.testacc_ApprovalResponse", "id",
+						"wallix-bastion_approval_response.testacc_ApprovalResponse", "approval_id"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_approval_response.testacc_ApprovalResponse",
+						"status", "accepted"),
+				),
+			},
+			{
+				Config: testAccResourceApprovalResponseCreate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.wallix-bastion_approvals.testacc_ApprovalResponse", "approvals.*",
+						map[string]string{
+							"ticket":                  "INC-1",
+							"status":                  "accepted",
+							"answers.#":               "1",
+							"answers.0.approved":      "true",
+							"answers.0.approver_name": "admin",
+							"answers.0.comment":       "testacc ApprovalResponse",
+						}),
+				),
+			},
+			{
+				Config:      testAccResourceApprovalResponseAgain(),
+				ExpectError: regexp.MustCompile(`is not pending`),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+// approvals are requested by user sessions, so the test answers the one
+// seeded in the fake bastion with the ticket INC-1.
+func testAccResourceApprovalResponseCreate() string {
+	return `
+data "wallix-bastion_approvals" "testacc_ApprovalResponse" {
+  user_name   = "jdoe"
+  target_name = "root@srv1:SSH"
+}
+
+resource "wallix-bastion_approval_response" "testacc_ApprovalResponse" {
+  approval_id = one([for v in data.wallix-bastion_approvals.testacc_ApprovalResponse.approvals : v.id if v.ticket == "INC-1"])
+  approved    = true
+  comment     = "testacc ApprovalResponse"
+  duration    = 3600
+}
+`
+}
+
+func testAccResourceApprovalResponseAgain() string {
+	return `
+data "wallix-bastion_approvals" "testacc_ApprovalResponse" {
+  user_name   = "jdoe"
+  target_name = "root@srv1:SSH"
+}
+
+resource "wallix-bastion_approval_response" "testacc_ApprovalResponse" {
+  approval_id = one([for v in data.wallix-bastion_approvals.testacc_ApprovalResponse.approvals : v.id if v.ticket == "INC-1"])
+  approved    = false
+  comment     = "testacc ApprovalResponse again"
+}
+`
+}
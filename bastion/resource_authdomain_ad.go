@@ -132,25 +132,25 @@ func resourceAuthDomainADCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainADVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceAuthDomainAD(ctx, d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s already exists", d.Get("domain_name").(string)))
+		return diagFromErr(fmt.Errorf("domain_name %s already exists", d.Get("domain_name").(string)))
 	}
 	err = addAuthDomainAD(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceAuthDomainAD(ctx, d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s not found after POST", d.Get("domain_name").(string)))
+		return diagFromErr(fmt.Errorf("domain_name %s not found after POST", d.Get("domain_name").(string)))
 	}
 	d.SetId(id)
 
@@ -162,11 +162,11 @@ func resourceAuthDomainADRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainADVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readAuthDomainADOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -183,10 +183,10 @@ func resourceAuthDomainADUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceAuthDomainADVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateAuthDomainAD(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -198,10 +198,10 @@ func resourceAuthDomainADDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainADVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteAuthDomainAD(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -241,24 +241,7 @@ func searchResourceAuthDomainAD(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/authdomains/?q=domain_name="+domainName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonAuthDomainAD
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/authdomains/", "domain_name", domainName, m)
 }
 
 func addAuthDomainAD(
@@ -271,7 +254,7 @@ func addAuthDomainAD(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -287,7 +270,7 @@ func updateAuthDomainAD(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -302,7 +285,7 @@ func deleteAuthDomainAD(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -354,11 +337,13 @@ func readAuthDomainADOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
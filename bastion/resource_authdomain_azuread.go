@@ -86,6 +86,18 @@ func resourceAuthDomainAzureAD() *schema.Resource {
 				Optional:  true,
 				Sensitive: true,
 			},
+			"client_secret_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"client_secret"},
+			},
+			"client_secret_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"client_secret_wo"},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -127,25 +139,25 @@ func resourceAuthDomainAzureADCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainAzureADVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceAuthDomainAzureAD(ctx, d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s already exists", d.Get("domain_name").(string)))
+		return diagFromErr(fmt.Errorf("domain_name %s already exists", d.Get("domain_name").(string)))
 	}
 	err = addAuthDomainAzureAD(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceAuthDomainAzureAD(ctx, d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s not found after POST", d.Get("domain_name").(string)))
+		return diagFromErr(fmt.Errorf("domain_name %s not found after POST", d.Get("domain_name").(string)))
 	}
 	d.SetId(id)
 
@@ -157,11 +169,11 @@ func resourceAuthDomainAzureADRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainAzureADVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readAuthDomainAzureADOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -178,10 +190,10 @@ func resourceAuthDomainAzureADUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceAuthDomainAzureADVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateAuthDomainAzureAD(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -193,10 +205,10 @@ func resourceAuthDomainAzureADDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainAzureADVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteAuthDomainAzureAD(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -236,24 +248,7 @@ func searchResourceAuthDomainAzureAD(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/authdomains/?q=domain_name="+domainName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonAuthDomainAzureAD
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/authdomains/", "domain_name", domainName, m)
 }
 
 func addAuthDomainAzureAD(
@@ -266,7 +261,7 @@ func addAuthDomainAzureAD(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -282,7 +277,7 @@ func updateAuthDomainAzureAD(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -297,7 +292,7 @@ func deleteAuthDomainAzureAD(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -314,7 +309,7 @@ func prepareAuthDomainAzureADJSON(d *schema.ResourceData) jsonAuthDomainAzureAD
 		EntityID:           d.Get("entity_id").(string),
 		Label:              d.Get("label").(string),
 		Certificate:        d.Get("certificate").(string),
-		ClientSecret:       d.Get("client_secret").(string),
+		ClientSecret:       secretString(d, "client_secret"),
 		Description:        d.Get("description").(string),
 		IsDefault:          d.Get("is_default").(bool),
 		Passphrase:         d.Get("passphrase").(string),
@@ -347,11 +342,13 @@ func readAuthDomainAzureADOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -132,25 +132,25 @@ func resourceAuthDomainLdapCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainLdapVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceAuthDomainLdap(ctx, d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s already exists", d.Get("domain_name").(string)))
+		return diagFromErr(fmt.Errorf("domain_name %s already exists", d.Get("domain_name").(string)))
 	}
 	err = addAuthDomainLdap(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceAuthDomainLdap(ctx, d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s not found after POST", d.Get("domain_name").(string)))
+		return diagFromErr(fmt.Errorf("domain_name %s not found after POST", d.Get("domain_name").(string)))
 	}
 	d.SetId(id)
 
@@ -162,11 +162,11 @@ func resourceAuthDomainLdapRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainLdapVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readAuthDomainLdapOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -183,10 +183,10 @@ func resourceAuthDomainLdapUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceAuthDomainLdapVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateAuthDomainLdap(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -198,10 +198,10 @@ func resourceAuthDomainLdapDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainLdapVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteAuthDomainLdap(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -241,24 +241,7 @@ func searchResourceAuthDomainLdap(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/authdomains/?q=domain_name="+domainName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonAuthDomainLdap
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/authdomains/", "domain_name", domainName, m)
 }
 
 func addAuthDomainLdap(
@@ -271,7 +254,7 @@ func addAuthDomainLdap(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -287,7 +270,7 @@ func updateAuthDomainLdap(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -302,7 +285,7 @@ func deleteAuthDomainLdap(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -354,11 +337,13 @@ func readAuthDomainLdapOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
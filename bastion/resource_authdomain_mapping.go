@@ -68,33 +68,33 @@ func resourceAuthDomainMappingCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainMappingVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	domainIDExists, err := checkAuthDomainID(ctx, d.Get("domain_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !domainIDExists {
-		return diag.FromErr(fmt.Errorf("auth domain with ID %s doesn't exists", d.Get("domain_id").(string)))
+		return diagFromErr(fmt.Errorf("auth domain with ID %s doesn't exists", d.Get("domain_id").(string)))
 	}
 	_, ex, err := searchResourceAuthDomainMapping(ctx, d.Get("domain_id").(string), d.Get("user_group").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("auth domain mapping for user_group %s on domain_id %s already exists",
+		return diagFromErr(fmt.Errorf("auth domain mapping for user_group %s on domain_id %s already exists",
 			d.Get("user_group").(string), d.Get("domain_id").(string)))
 	}
 	err = addAuthDomainMapping(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceAuthDomainMapping(ctx, d.Get("domain_id").(string), d.Get("user_group").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("auth domain mapping for user_group %s on domain_id %s not found after POST",
+		return diagFromErr(fmt.Errorf("auth domain mapping for user_group %s on domain_id %s not found after POST",
 			d.Get("user_group").(string), d.Get("domain_id").(string)))
 	}
 	d.SetId(id)
@@ -107,11 +107,11 @@ func resourceAuthDomainMappingRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainMappingVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readAuthDomainMappingOptions(ctx, d.Get("domain_id").(string), d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -128,10 +128,10 @@ func resourceAuthDomainMappingUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceAuthDomainMappingVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateAuthDomainMapping(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -143,10 +143,10 @@ func resourceAuthDomainMappingDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceAuthDomainMappingVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteAuthDomainMapping(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -196,11 +196,13 @@ func checkAuthDomainID(
 	if err != nil {
 		return false, err
 	}
-	if code == http.StatusNotFound {
-		return false, nil
-	}
 	if code != http.StatusOK {
-		return false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return false, nil
+		}
+
+		return false, apiErr
 	}
 	var result jsonAuthDomain
 	err = json.Unmarshal([]byte(body), &result)
@@ -219,29 +221,7 @@ func searchResourceAuthDomainMapping(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(
-		ctx,
-		"/authdomains/"+domainID+"/mappings/?q=user_group="+userGroup,
-		http.MethodGet,
-		nil,
-	)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonAuthDomainMapping
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/authdomains/"+domainID+"/mappings/", "user_group", userGroup, m)
 }
 
 func addAuthDomainMapping(
@@ -259,7 +239,7 @@ func addAuthDomainMapping(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -280,7 +260,7 @@ func updateAuthDomainMapping(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -300,7 +280,7 @@ func deleteAuthDomainMapping(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -329,11 +309,13 @@ func readAuthDomainMappingOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -1,9 +1,11 @@
 package bastion_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourceAuthorization_basic(t *testing.T) {
@@ -67,6 +69,39 @@ func TestAccResourceAuthorization_sessionSharing(t *testing.T) {
 	})
 }
 
+// TestAccResourceAuthorization_refreshNullCollections checks that approvers,
+// not set and read empty, stays null after a refresh with the server of the
+// provider.
+func TestAccResourceAuthorization_refreshNullCollections(t *testing.T) {
+	resourceName := "wallix-bastion_authorization.testacc_Authorization_sharing"
+	checkNull := func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Resource %s not found", resourceName)
+		}
+		if v, ok := rs.Primary.Attributes["approvers.#"]; ok {
+			return fmt.Errorf("approvers is %s elements instead of null", v)
+		}
+
+		return nil
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthorizationSessionSharingViewOnly(),
+				Check:  checkNull,
+			},
+			{
+				RefreshState: true,
+				Check:        checkNull,
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
 // nolint: lll, nolintlint
 func testAccResourceAuthorizationCreate() string {
 	return `
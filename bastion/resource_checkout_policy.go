@@ -82,25 +82,25 @@ func resourceCheckoutPolicyCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceCheckoutPolicyVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceCheckoutPolicy(ctx, d.Get("checkout_policy_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("checkout_policy_name %s already exists", d.Get("checkout_policy_name").(string)))
+		return diagFromErr(fmt.Errorf("checkout_policy_name %s already exists", d.Get("checkout_policy_name").(string)))
 	}
 	err = addCheckoutPolicy(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceCheckoutPolicy(ctx, d.Get("checkout_policy_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("checkout_policy_name %s not found after POST",
+		return diagFromErr(fmt.Errorf("checkout_policy_name %s not found after POST",
 			d.Get("checkout_policy_name").(string)))
 	}
 	d.SetId(id)
@@ -113,11 +113,11 @@ func resourceCheckoutPolicyRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceCheckoutPolicyVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readCheckoutPolicyOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -134,10 +134,10 @@ func resourceCheckoutPolicyUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceCheckoutPolicyVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateCheckoutPolicy(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -149,10 +149,10 @@ func resourceCheckoutPolicyDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceCheckoutPolicyVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteCheckoutPolicy(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -192,25 +192,7 @@ func searchResourceCheckoutPolicy(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx,
-		"/checkoutpolicies/?q=checkout_policy_name="+checkoutPolicyName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonCheckoutPolicy
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/checkoutpolicies/", "checkout_policy_name", checkoutPolicyName, m)
 }
 
 func addCheckoutPolicy(
@@ -223,7 +205,7 @@ func addCheckoutPolicy(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -239,7 +221,7 @@ func updateCheckoutPolicy(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -254,7 +236,7 @@ func deleteCheckoutPolicy(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -285,11 +267,13 @@ func readCheckoutPolicyOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
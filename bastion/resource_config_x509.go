@@ -36,8 +36,21 @@ func resourceConfigX509() *schema.Resource {
 				Required: true,
 			},
 			"server_private_key": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"server_private_key", "server_private_key_wo"},
+			},
+			"server_private_key_wo": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"server_private_key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"server_private_key_wo"},
 			},
 			"enable": {
 				Type:     schema.TypeBool,
@@ -50,7 +63,7 @@ func resourceConfigX509() *schema.Resource {
 func resourceConfigX509Create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Add the configuration
 	if err := addConfigX509(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	// Use a static ID since the API does not provide one
 	d.SetId("x509Config")
@@ -61,7 +74,7 @@ func resourceConfigX509Create(ctx context.Context, d *schema.ResourceData, m int
 func resourceConfigX509Read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	cfg, err := readConfigX509Options(ctx, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// If no config exists, mark the resource as deleted
@@ -72,7 +85,7 @@ func resourceConfigX509Read(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	if err := fillConfigX509(d, cfg); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -80,7 +93,7 @@ func resourceConfigX509Read(ctx context.Context, d *schema.ResourceData, m inter
 
 func resourceConfigX509Update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if err := updateConfigX509(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceConfigX509Read(ctx, d, m)
@@ -88,7 +101,7 @@ func resourceConfigX509Update(ctx context.Context, d *schema.ResourceData, m int
 
 func resourceConfigX509Delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if err := deleteConfigX509(ctx, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Remove the resource from state
@@ -112,7 +125,7 @@ func addConfigX509(ctx context.Context, d *schema.ResourceData, m interface{}) e
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("API returned error: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -125,11 +138,13 @@ func readConfigX509Options(ctx context.Context, m interface{}) (jsonConfigX509,
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("API returned error: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -147,7 +162,7 @@ func updateConfigX509(ctx context.Context, d *schema.ResourceData, m interface{}
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("API returned error: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -160,7 +175,7 @@ func deleteConfigX509(ctx context.Context, m interface{}) error {
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("API returned error: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -170,21 +185,26 @@ func prepareConfigX509JSON(d *schema.ResourceData) jsonConfigX509 {
 	return jsonConfigX509{
 		CaCertificate:    d.Get("ca_certificate").(string),
 		ServerPublicKey:  d.Get("server_public_key").(string),
-		ServerPrivateKey: d.Get("server_private_key").(string),
+		ServerPrivateKey: secretString(d, "server_private_key"),
 		Enable:           d.Get("enable").(bool),
 	}
 }
 
 //nolint:wrapcheck
 func fillConfigX509(d *schema.ResourceData, jsonData jsonConfigX509) error {
+	// keep the private key out of the state when it's given with server_private_key_wo,
+	// except on import where the configuration isn't known yet
+	fillPrivateKey := d.Get("server_private_key").(string) != "" || d.Get("server_public_key").(string) == ""
 	if err := d.Set("ca_certificate", jsonData.CaCertificate); err != nil {
 		return err
 	}
 	if err := d.Set("server_public_key", jsonData.ServerPublicKey); err != nil {
 		return err
 	}
-	if err := d.Set("server_private_key", jsonData.ServerPrivateKey); err != nil {
-		return err
+	if fillPrivateKey {
+		if err := d.Set("server_private_key", jsonData.ServerPrivateKey); err != nil {
+			return err
+		}
 	}
 	if err := d.Set("enable", jsonData.Enable); err != nil {
 		return err
@@ -60,10 +60,10 @@ func resourceConnectionMessageCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceConnectionMessageVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateConnectionMessage(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.SetId(d.Get("message_name").(string))
 
@@ -75,11 +75,11 @@ func resourceConnectionMessageRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceConnectionMessageVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readConnectionMessage(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg.MessageName = d.Get("message_name").(string)
 	fillConnectionMessage(d, cfg)
@@ -93,10 +93,10 @@ func resourceConnectionMessageUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceConnectionMessageVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateConnectionMessage(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -145,7 +145,7 @@ func updateConnectionMessage(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -170,11 +170,13 @@ func readConnectionMessage(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"golang.org/x/mod/semver"
 )
 
 type jsonConnectionPolicy struct {
@@ -32,6 +31,7 @@ func resourceConnectionPolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceConnectionPolicyImport,
 		},
+		CustomizeDiff: resourceConnectionPolicyCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"connection_policy_name": {
 				Type:     schema.TypeString,
@@ -81,30 +81,44 @@ func resourceConnectionPolicyVersionCheck(version string) error {
 	return fmt.Errorf("resource wallix-bastion_connection_policy not available with api version %s", version)
 }
 
+func resourceConnectionPolicyCustomizeDiff(
+	_ context.Context, d *schema.ResourceDiff, m interface{},
+) error {
+	c, ok := m.(*Client)
+	if !ok {
+		return nil
+	}
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr("type").IsNull() {
+		return c.checkCapability(capabilityConnectionPolicyType, "type")
+	}
+
+	return nil
+}
+
 func resourceConnectionPolicyCreate(
 	ctx context.Context, d *schema.ResourceData, m interface{},
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceConnectionPolicyVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceConnectionPolicy(ctx, d.Get("connection_policy_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("connection_policy_name %s already exists", d.Get("connection_policy_name").(string)))
+		return diagFromErr(fmt.Errorf("connection_policy_name %s already exists", d.Get("connection_policy_name").(string)))
 	}
-	err = addConnectionPolicy(ctx, d, m, c.bastionAPIVersion)
+	err = addConnectionPolicy(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceConnectionPolicy(ctx, d.Get("connection_policy_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("connection_policy_name %s not found after POST",
+		return diagFromErr(fmt.Errorf("connection_policy_name %s not found after POST",
 			d.Get("connection_policy_name").(string)))
 	}
 	d.SetId(id)
@@ -117,11 +131,11 @@ func resourceConnectionPolicyRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceConnectionPolicyVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readConnectionPolicyOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -138,10 +152,10 @@ func resourceConnectionPolicyUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceConnectionPolicyVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
-	if err := updateConnectionPolicy(ctx, d, m, c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+	if err := updateConnectionPolicy(ctx, d, m); err != nil {
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -153,10 +167,10 @@ func resourceConnectionPolicyDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceConnectionPolicyVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteConnectionPolicy(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -196,32 +210,14 @@ func searchResourceConnectionPolicy(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx,
-		"/connectionpolicies/?q=connection_policy_name="+connectionPolicyName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonConnectionPolicy
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/connectionpolicies/", "connection_policy_name", connectionPolicyName, m)
 }
 
 func addConnectionPolicy(
-	ctx context.Context, d *schema.ResourceData, m interface{}, apiVersion string,
+	ctx context.Context, d *schema.ResourceData, m interface{},
 ) error {
 	c := m.(*Client)
-	jsonData, err := prepareConnectionPolicyJSON(d, true, apiVersion)
+	jsonData, err := prepareConnectionPolicyJSON(d, true, c)
 	if err != nil {
 		return err
 	}
@@ -230,17 +226,17 @@ func addConnectionPolicy(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
 }
 
 func updateConnectionPolicy(
-	ctx context.Context, d *schema.ResourceData, m interface{}, apiVersion string,
+	ctx context.Context, d *schema.ResourceData, m interface{},
 ) error {
 	c := m.(*Client)
-	jsonData, err := prepareConnectionPolicyJSON(d, false, apiVersion)
+	jsonData, err := prepareConnectionPolicyJSON(d, false, c)
 	if err != nil {
 		return err
 	}
@@ -249,7 +245,7 @@ func updateConnectionPolicy(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -264,14 +260,14 @@ func deleteConnectionPolicy(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
 }
 
 func prepareConnectionPolicyJSON(
-	d *schema.ResourceData, newResource bool, apiVersion string,
+	d *schema.ResourceData, newResource bool, c *Client,
 ) (
 	jsonConnectionPolicy, error,
 ) {
@@ -281,7 +277,7 @@ func prepareConnectionPolicyJSON(
 	}
 	if newResource {
 		jsonData.Protocol = d.Get("protocol").(string)
-		if semver.Compare(apiVersion, VersionWallixAPI312) >= 0 {
+		if c.hasCapability(capabilityConnectionPolicyType) {
 			if v := d.Get("type").(string); v != "" {
 				jsonData.Type = v
 			} else {
@@ -332,11 +328,13 @@ func readConnectionPolicyOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -1,6 +1,8 @@
 package bastion_test
 
 import (
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -32,6 +34,30 @@ func TestAccResourceConnectionPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceConnectionPolicy_typeWithAPI38(t *testing.T) {
+	if v := os.Getenv("WALLIX_BASTION_API_VERSION"); v != "" && v != "v3.8" {
+		t.Skip("WALLIX_BASTION_API_VERSION isn't v3.8")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "wallix-bastion_connection_policy" "testacc_ConnectionPolicyType" {
+  connection_policy_name = "testacc_ConnectionPolicyType"
+  protocol               = "RDP"
+  type                   = "RDP-JUMPHOST"
+  authentication_methods = ["PASSWORD_VAULT"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`type requires bastion 12\.0 or later \(api version v3\.12\)`),
+			},
+		},
+	})
+}
+
 // nolint: lll, nolintlint
 func testAccResourceConnectionPolicyCreate() string {
 	return `
@@ -146,25 +146,25 @@ func resourceDeviceCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceDevice(ctx, d.Get("device_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("device_name %s already exists", d.Get("device_name").(string)))
+		return diagFromErr(fmt.Errorf("device_name %s already exists", d.Get("device_name").(string)))
 	}
 	err = addDevice(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceDevice(ctx, d.Get("device_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("device_name %s not found after POST", d.Get("device_name").(string)))
+		return diagFromErr(fmt.Errorf("device_name %s not found after POST", d.Get("device_name").(string)))
 	}
 	d.SetId(id)
 
@@ -176,11 +176,11 @@ func resourceDeviceRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readDeviceOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -197,10 +197,10 @@ func resourceDeviceUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceDeviceVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateDevice(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -212,10 +212,10 @@ func resourceDeviceDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteDevice(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -255,24 +255,7 @@ func searchResourceDevice(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/devices/?q=device_name="+deviceName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonDevice
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/devices/", "device_name", deviceName, m)
 }
 
 func addDevice(
@@ -285,7 +268,7 @@ func addDevice(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -301,7 +284,7 @@ func updateDevice(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -316,7 +299,7 @@ func deleteDevice(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -342,11 +325,13 @@ func readDeviceOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -369,6 +354,18 @@ func fillDevice(d *schema.ResourceData, jsonData jsonDevice) {
 	if tfErr := d.Set("description", jsonData.Description); tfErr != nil {
 		panic(tfErr)
 	}
+	if tfErr := d.Set("local_domains", flattenDeviceLocalDomains(jsonData)); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("services", flattenDeviceServices(jsonData)); tfErr != nil {
+		panic(tfErr)
+	}
+}
+
+func flattenDeviceLocalDomains(jsonData jsonDevice) []map[string]interface{} {
+	if jsonData.LocalDomains == nil {
+		return make([]map[string]interface{}, 0)
+	}
 	localDomains := make([]map[string]interface{}, len(*jsonData.LocalDomains))
 	for i, v := range *jsonData.LocalDomains {
 		localDomains[i] = map[string]interface{}{
@@ -384,8 +381,13 @@ func fillDevice(d *schema.ResourceData, jsonData jsonDevice) {
 		pluginParameters, _ := json.Marshal(v.PasswordChangePluginParameters) //nolint: errchkjson
 		localDomains[i]["password_change_plugin_parameters"] = string(pluginParameters)
 	}
-	if tfErr := d.Set("local_domains", localDomains); tfErr != nil {
-		panic(tfErr)
+
+	return localDomains
+}
+
+func flattenDeviceServices(jsonData jsonDevice) []map[string]interface{} {
+	if jsonData.Services == nil {
+		return make([]map[string]interface{}, 0)
 	}
 	services := make([]map[string]interface{}, len(*jsonData.Services))
 	for i, v := range *jsonData.Services {
@@ -408,7 +410,6 @@ func fillDevice(d *schema.ResourceData, jsonData jsonDevice) {
 		}
 		services[i] = service
 	}
-	if tfErr := d.Set("services", services); tfErr != nil {
-		panic(tfErr)
-	}
+
+	return services
 }
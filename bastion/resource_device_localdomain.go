@@ -61,6 +61,18 @@ func resourceDeviceLocalDomain() *schema.Resource {
 				Optional:  true,
 				Sensitive: true,
 			},
+			"ca_private_key_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"ca_private_key"},
+			},
+			"ca_private_key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"ca_private_key_wo"},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -76,6 +88,14 @@ func resourceDeviceLocalDomain() *schema.Resource {
 				Sensitive:    true,
 				RequiredWith: []string{"ca_private_key"},
 			},
+			"passphrase_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"passphrase"},
+				RequiredWith:  []string{"ca_private_key_wo"},
+			},
 			"password_change_policy": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -110,33 +130,33 @@ func resourceDeviceLocalDomainCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfgDevice, err := readDeviceOptions(ctx, d.Get("device_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgDevice.ID == "" {
-		return diag.FromErr(fmt.Errorf("device with ID %s doesn't exists", d.Get("device_id").(string)))
+		return diagFromErr(fmt.Errorf("device with ID %s doesn't exists", d.Get("device_id").(string)))
 	}
 	_, ex, err := searchResourceDeviceLocalDomain(ctx, d.Get("device_id").(string), d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s on device_id %s already exists",
+		return diagFromErr(fmt.Errorf("domain_name %s on device_id %s already exists",
 			d.Get("domain_name").(string), d.Get("device_id").(string)))
 	}
 	err = addDeviceLocalDomain(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceDeviceLocalDomain(ctx, d.Get("device_id").(string), d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s on device_id %s not found after POST",
+		return diagFromErr(fmt.Errorf("domain_name %s on device_id %s not found after POST",
 			d.Get("domain_name").(string), d.Get("device_id").(string)))
 	}
 	d.SetId(id)
@@ -149,11 +169,11 @@ func resourceDeviceLocalDomainRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readDeviceLocalDomainOptions(ctx, d.Get("device_id").(string), d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -170,10 +190,10 @@ func resourceDeviceLocalDomainUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateDeviceLocalDomain(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -185,10 +205,10 @@ func resourceDeviceLocalDomainDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteDeviceLocalDomain(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -235,25 +255,7 @@ func searchResourceDeviceLocalDomain(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/devices/"+deviceID+
-		"/localdomains/?q=domain_name="+domainName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonDeviceLocalDomain
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/devices/"+deviceID+"/localdomains/", "domain_name", domainName, m)
 }
 
 func addDeviceLocalDomain(
@@ -267,7 +269,7 @@ func addDeviceLocalDomain(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -284,7 +286,7 @@ func updateDeviceLocalDomain(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -300,7 +302,7 @@ func deleteDeviceLocalDomain(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -310,11 +312,11 @@ func prepareDeviceLocalDomainJSON(d *schema.ResourceData, newResource bool) json
 	jsonData := jsonDeviceLocalDomain{
 		Description: d.Get("description").(string),
 		DomainName:  d.Get("domain_name").(string),
-		Passphrase:  d.Get("passphrase").(string),
+		Passphrase:  secretString(d, "passphrase"),
 	}
 
 	if !strings.HasPrefix(d.Get("ca_private_key").(string), "generate:") {
-		jsonData.CAPrivateKey = d.Get("ca_private_key").(string)
+		jsonData.CAPrivateKey = secretString(d, "ca_private_key")
 	} else if d.HasChange("ca_private_key") {
 		oldKey, newKey := d.GetChange("ca_private_key")
 		if oldKey.(string) == "" {
@@ -354,11 +356,13 @@ func readDeviceLocalDomainOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -122,43 +122,43 @@ func resourceDeviceLocalDomainAccountCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfgDevice, err := readDeviceOptions(ctx, d.Get("device_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgDevice.ID == "" {
-		return diag.FromErr(fmt.Errorf("device with ID %s doesn't exists", d.Get("device_id").(string)))
+		return diagFromErr(fmt.Errorf("device with ID %s doesn't exists", d.Get("device_id").(string)))
 	}
 	cfgDomain, err := readDeviceLocalDomainOptions(ctx, d.Get("device_id").(string), d.Get("domain_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgDomain.ID == "" {
-		return diag.FromErr(fmt.Errorf("domain_id with ID %s on device_id %s doesn't exists",
+		return diagFromErr(fmt.Errorf("domain_id with ID %s on device_id %s doesn't exists",
 			d.Get("domain_id").(string), d.Get("device_id").(string)))
 	}
 	_, ex, err := searchResourceDeviceLocalDomainAccount(ctx,
 		d.Get("device_id").(string), d.Get("domain_id").(string), d.Get("account_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("account_name %s on domain_id %s, device_id %s already exists",
+		return diagFromErr(fmt.Errorf("account_name %s on domain_id %s, device_id %s already exists",
 			d.Get("account_name").(string), d.Get("domain_id").(string), d.Get("device_id").(string)))
 	}
 	err = addDeviceLocalDomainAccount(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceDeviceLocalDomainAccount(ctx,
 		d.Get("device_id").(string), d.Get("domain_id").(string), d.Get("account_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("account_name %s on domain_id %s, device_id %s not found after POST",
+		return diagFromErr(fmt.Errorf("account_name %s on domain_id %s, device_id %s not found after POST",
 			d.Get("account_name").(string), d.Get("domain_id").(string), d.Get("device_id").(string)))
 	}
 	d.SetId(id)
@@ -171,12 +171,12 @@ func resourceDeviceLocalDomainAccountRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readDeviceLocalDomainAccountOptions(ctx,
 		d.Get("device_id").(string), d.Get("domain_id").(string), d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -193,10 +193,10 @@ func resourceDeviceLocalDomainAccountUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateDeviceLocalDomainAccount(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -208,10 +208,10 @@ func resourceDeviceLocalDomainAccountDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteDeviceLocalDomainAccount(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -262,25 +262,8 @@ func searchResourceDeviceLocalDomainAccount(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/devices/"+deviceID+"/localdomains/"+domainID+
-		"/accounts/?q=account_name="+accountName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonDeviceLocalDomainAccount
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx,
+		"/devices/"+deviceID+"/localdomains/"+domainID+"/accounts/", "account_name", accountName, m)
 }
 
 func addDeviceLocalDomainAccount(
@@ -295,7 +278,7 @@ func addDeviceLocalDomainAccount(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -313,7 +296,7 @@ func updateDeviceLocalDomainAccount(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -330,7 +313,7 @@ func deleteDeviceLocalDomainAccount(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -369,11 +352,13 @@ func readDeviceLocalDomainAccountOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -20,6 +20,7 @@ func resourceDeviceLocalDomainAccountCredential() *schema.Resource {
 		ReadContext:   resourceDeviceLocalDomainAccountCredentialRead,
 		UpdateContext: resourceDeviceLocalDomainAccountCredentialUpdate,
 		DeleteContext: resourceDeviceLocalDomainAccountCredentialDelete,
+		CustomizeDiff: credentialRotationCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceDeviceLocalDomainAccountCredentialImport,
 		},
@@ -62,10 +63,57 @@ func resourceDeviceLocalDomainAccountCredential() *schema.Resource {
 				Sensitive: true,
 				ForceNew:  true,
 			},
+			"passphrase_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"passphrase"},
+				RequiredWith:  []string{"private_key_wo"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"password"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+			},
+			"private_key_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"private_key"},
+			},
+			"private_key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"private_key_wo"},
+			},
 			"public_key": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: credentialFingerprintDescription,
+			},
+			"ignore_rotation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: credentialIgnoreRotationDescription,
+			},
+			"rotated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -84,52 +132,52 @@ func resourceDeviceLocalDomainAccountCredentialCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainAccountCredentialVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfgDevice, err := readDeviceOptions(ctx, d.Get("device_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgDevice.ID == "" {
-		return diag.FromErr(fmt.Errorf("device with ID %s doesn't exists", d.Get("device_id").(string)))
+		return diagFromErr(fmt.Errorf("device with ID %s doesn't exists", d.Get("device_id").(string)))
 	}
 	cfgDomain, err := readDeviceLocalDomainOptions(ctx, d.Get("device_id").(string), d.Get("domain_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgDomain.ID == "" {
-		return diag.FromErr(fmt.Errorf("domain_id with ID %s on device_id %s doesn't exists",
+		return diagFromErr(fmt.Errorf("domain_id with ID %s on device_id %s doesn't exists",
 			d.Get("domain_id").(string), d.Get("device_id").(string)))
 	}
 	cfgAccount, err := readDeviceLocalDomainAccountOptions(ctx,
 		d.Get("device_id").(string), d.Get("domain_id").(string), d.Get("account_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgAccount.ID == "" {
-		return diag.FromErr(fmt.Errorf("account_id with ID %s on domain_id %s, device_id %s doesn't exists",
+		return diagFromErr(fmt.Errorf("account_id with ID %s on domain_id %s, device_id %s doesn't exists",
 			d.Get("account_id").(string), d.Get("domain_id").(string), d.Get("device_id").(string)))
 	}
 	_, ex, err := searchResourceDeviceLocalDomainAccountCredential(ctx,
 		d.Get("device_id").(string), d.Get("domain_id").(string), d.Get("account_id").(string), d.Get("type").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("credential type %s on account_id %s, domain_id %s, device_id %s already exists",
+		return diagFromErr(fmt.Errorf("credential type %s on account_id %s, domain_id %s, device_id %s already exists",
 			d.Get("type").(string), d.Get("account_id").(string), d.Get("domain_id").(string), d.Get("device_id").(string)))
 	}
 	err = addDeviceLocalDomainAccountCredential(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceDeviceLocalDomainAccountCredential(ctx,
 		d.Get("device_id").(string), d.Get("domain_id").(string), d.Get("account_id").(string), d.Get("type").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf(
+		return diagFromErr(fmt.Errorf(
 			"credential type %s on account_id %s, domain_id %s, device_id %s not found after POST",
 			d.Get("type").(string), d.Get("account_id").(string), d.Get("domain_id").(string), d.Get("device_id").(string)))
 	}
@@ -143,12 +191,12 @@ func resourceDeviceLocalDomainAccountCredentialRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainAccountCredentialVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readDeviceLocalDomainAccountCredentialOptions(ctx,
 		d.Get("device_id").(string), d.Get("domain_id").(string), d.Get("account_id").(string), d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -165,10 +213,13 @@ func resourceDeviceLocalDomainAccountCredentialUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainAccountCredentialVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
-	if err := updateDeviceLocalDomainAccountCredential(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+	if d.HasChangeExcept("ignore_rotation") {
+		if err := updateDeviceLocalDomainAccountCredential(ctx, d, m); err != nil {
+			return diagFromErr(err)
+		}
+		resetCredentialRotation(d)
 	}
 	d.Partial(false)
 
@@ -180,10 +231,10 @@ func resourceDeviceLocalDomainAccountCredentialDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceLocalDomainAccountCredentialVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteDeviceLocalDomainAccountCredential(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -245,7 +296,7 @@ func searchResourceDeviceLocalDomainAccountCredential(
 		return "", false, err
 	}
 	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		return "", false, newAPIError(code, body)
 	}
 	var results []jsonCredential
 	err = json.Unmarshal([]byte(body), &results)
@@ -273,7 +324,7 @@ func addDeviceLocalDomainAccountCredential(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -291,7 +342,7 @@ func updateDeviceLocalDomainAccountCredential(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -308,7 +359,7 @@ func deleteDeviceLocalDomainAccountCredential(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -323,10 +374,10 @@ func prepareDeviceLocalDomainAccountCredentialJSON(
 
 	switch jsonData.Type {
 	case "password":
-		jsonData.Password = d.Get("password").(string)
+		jsonData.Password = secretString(d, "password")
 	case "ssh_key":
-		jsonData.PrivateKey = d.Get("private_key").(string)
-		jsonData.Passphrase = d.Get("passphrase").(string)
+		jsonData.PrivateKey = secretString(d, "private_key")
+		jsonData.Passphrase = secretString(d, "passphrase")
 	}
 
 	return jsonData
@@ -345,11 +396,13 @@ func readDeviceLocalDomainAccountCredentialOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -378,4 +431,5 @@ func fillDeviceLocalDomainAccountCredential(d *schema.ResourceData, jsonData jso
 	if tfErr := d.Set("public_key", jsonData.PublicKey); tfErr != nil {
 		panic(tfErr)
 	}
+	fillCredentialRotation(d, jsonData)
 }
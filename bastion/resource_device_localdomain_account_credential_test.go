@@ -141,3 +141,67 @@ resource "tls_private_key" "testacc_DomainAccountCred" {
 }
 `
 }
+
+func TestAccResourceDeviceLocalDomainAccountCred_rotation(t *testing.T) {
+	resourceName := "wallix-bastion_device_localdomain_account_credential.testacc_DeviceLocalDomainAccountCredRotation"
+	target := "testacc_DeviceLocalDomainAccountCredRotation_admin" +
+		"@testacc_DeviceLocalDomainAccountCredRotation@testacc_DeviceLocalDomainAccountCredRotation"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if testAccFakeBastion == nil {
+				t.Skip("rotating a credential outside of Terraform requires the fake bastion")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDeviceLocalDomainAccountCredRotation(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "fingerprint"),
+					resource.TestCheckResourceAttr(resourceName, "rotated", "false"),
+				),
+			},
+			{
+				PreConfig:          testAccFakeBastionSetCredential(t, target, "ssh_key", "public_key", "ssh-rsa AAAArotated"),
+				Config:             testAccResourceDeviceLocalDomainAccountCredRotation(),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceDeviceLocalDomainAccountCredRotation(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotated", "false"),
+					testAccCheckFakeBastionCredential(target, "ssh_key", "private_key", "aPrivateKey"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccResourceDeviceLocalDomainAccountCredRotation() string {
+	return `
+resource "wallix-bastion_device" "testacc_DeviceLocalDomainAccountCredRotation" {
+  device_name = "testacc_DeviceLocalDomainAccountCredRotation"
+  host        = "testacc_localdomain_account_rotation.device"
+}
+resource "wallix-bastion_device_localdomain" "testacc_DeviceLocalDomainAccountCredRotation" {
+  device_id   = wallix-bastion_device.testacc_DeviceLocalDomainAccountCredRotation.id
+  domain_name = "testacc_DeviceLocalDomainAccountCredRotation"
+}
+resource "wallix-bastion_device_localdomain_account" "testacc_DeviceLocalDomainAccountCredRotation" {
+  device_id     = wallix-bastion_device.testacc_DeviceLocalDomainAccountCredRotation.id
+  domain_id     = wallix-bastion_device_localdomain.testacc_DeviceLocalDomainAccountCredRotation.id
+  account_name  = "testacc_DeviceLocalDomainAccountCredRotation_admin"
+  account_login = "admin"
+}
+resource "wallix-bastion_device_localdomain_account_credential" "testacc_DeviceLocalDomainAccountCredRotation" {
+  device_id   = wallix-bastion_device.testacc_DeviceLocalDomainAccountCredRotation.id
+  domain_id   = wallix-bastion_device_localdomain.testacc_DeviceLocalDomainAccountCredRotation.id
+  account_id  = wallix-bastion_device_localdomain_account.testacc_DeviceLocalDomainAccountCredRotation.id
+  type        = "ssh_key"
+  private_key = "aPrivateKey"
+}
+`
+}
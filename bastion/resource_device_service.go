@@ -90,33 +90,33 @@ func resourceDeviceServiceCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceServiceVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readDeviceOptions(ctx, d.Get("device_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
-		return diag.FromErr(fmt.Errorf("device with ID %s doesn't exists", d.Get("device_id").(string)))
+		return diagFromErr(fmt.Errorf("device with ID %s doesn't exists", d.Get("device_id").(string)))
 	}
 	_, ex, err := searchResourceDeviceService(ctx, d.Get("device_id").(string), d.Get("service_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("service_name %s on device_id %s already exists",
+		return diagFromErr(fmt.Errorf("service_name %s on device_id %s already exists",
 			d.Get("service_name").(string), d.Get("device_id").(string)))
 	}
 	err = addDeviceService(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceDeviceService(ctx, d.Get("device_id").(string), d.Get("service_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("service_name %s on device_id %s not found after POST",
+		return diagFromErr(fmt.Errorf("service_name %s on device_id %s not found after POST",
 			d.Get("service_name").(string), d.Get("device_id").(string)))
 	}
 	d.SetId(id)
@@ -129,11 +129,11 @@ func resourceDeviceServiceRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceServiceVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readDeviceServiceOptions(ctx, d.Get("device_id").(string), d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -150,10 +150,10 @@ func resourceDeviceServiceUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceDeviceVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateDeviceService(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -165,10 +165,10 @@ func resourceDeviceServiceDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDeviceServiceVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteDeviceService(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -215,25 +215,7 @@ func searchResourceDeviceService(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/devices/"+deviceID+
-		"/services/?q=service_name="+serviceName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonDeviceService
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/devices/"+deviceID+"/services/", "service_name", serviceName, m)
 }
 
 func addDeviceService(
@@ -249,7 +231,7 @@ func addDeviceService(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -269,7 +251,7 @@ func updateDeviceService(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -285,7 +267,7 @@ func deleteDeviceService(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -380,11 +362,13 @@ func readDeviceServiceOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -63,6 +63,18 @@ func resourceDomain() *schema.Resource {
 				Sensitive:     true,
 				ConflictsWith: []string{"vault_plugin"},
 			},
+			"ca_private_key_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"ca_private_key", "vault_plugin"},
+			},
+			"ca_private_key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"ca_private_key_wo"},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -79,6 +91,14 @@ func resourceDomain() *schema.Resource {
 				Sensitive:    true,
 				RequiredWith: []string{"ca_private_key"},
 			},
+			"passphrase_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"passphrase"},
+				RequiredWith:  []string{"ca_private_key_wo"},
+			},
 			"password_change_policy": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -127,25 +147,25 @@ func resourceDomainCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceDomain(ctx, d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s already exists", d.Get("domain_name").(string)))
+		return diagFromErr(fmt.Errorf("domain_name %s already exists", d.Get("domain_name").(string)))
 	}
 	err = addDomain(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceDomain(ctx, d.Get("domain_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("domain_name %s not found after POST", d.Get("domain_name").(string)))
+		return diagFromErr(fmt.Errorf("domain_name %s not found after POST", d.Get("domain_name").(string)))
 	}
 	d.SetId(id)
 
@@ -157,11 +177,11 @@ func resourceDomainRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readDomainOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -178,10 +198,10 @@ func resourceDomainUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateDomain(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -193,10 +213,10 @@ func resourceDomainDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDomainVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteDomain(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -236,24 +256,7 @@ func searchResourceDomain(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/domains/?q=domain_name="+domainName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonDomain
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/domains/", "domain_name", domainName, m)
 }
 
 func addDomain(
@@ -266,7 +269,7 @@ func addDomain(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -282,7 +285,7 @@ func updateDomain(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -297,7 +300,7 @@ func deleteDomain(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -308,11 +311,11 @@ func prepareDomainJSON(d *schema.ResourceData, newResource bool) jsonDomain {
 		Description:    d.Get("description").(string),
 		DomainName:     d.Get("domain_name").(string),
 		DomainRealName: d.Get("domain_real_name").(string),
-		Passphrase:     d.Get("passphrase").(string),
+		Passphrase:     secretString(d, "passphrase"),
 	}
 
 	if !strings.HasPrefix(d.Get("ca_private_key").(string), "generate:") {
-		jsonData.CAPrivateKey = d.Get("ca_private_key").(string)
+		jsonData.CAPrivateKey = secretString(d, "ca_private_key")
 	} else if d.HasChange("ca_private_key") {
 		oldKey, newKey := d.GetChange("ca_private_key")
 		if oldKey.(string) == "" {
@@ -362,11 +365,13 @@ func readDomainOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
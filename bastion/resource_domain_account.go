@@ -118,33 +118,33 @@ func resourceDomainAccountCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfgDomain, err := readDomainOptions(ctx, d.Get("domain_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgDomain.ID == "" {
-		return diag.FromErr(fmt.Errorf("domain_id with ID %s doesn't exists", d.Get("domain_id").(string)))
+		return diagFromErr(fmt.Errorf("domain_id with ID %s doesn't exists", d.Get("domain_id").(string)))
 	}
 	_, ex, err := searchResourceDomainAccount(ctx, d.Get("domain_id").(string), d.Get("account_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("account_name %s on domain_id %s already exists",
+		return diagFromErr(fmt.Errorf("account_name %s on domain_id %s already exists",
 			d.Get("account_name").(string), d.Get("domain_id").(string)))
 	}
 	err = addDomainAccount(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceDomainAccount(ctx, d.Get("domain_id").(string), d.Get("account_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("account_name %s on domain_id %s not found after POST",
+		return diagFromErr(fmt.Errorf("account_name %s on domain_id %s not found after POST",
 			d.Get("account_name").(string), d.Get("domain_id").(string)))
 	}
 	d.SetId(id)
@@ -157,11 +157,11 @@ func resourceDomainAccountRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readDomainAccountOptions(ctx, d.Get("domain_id").(string), d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -178,10 +178,10 @@ func resourceDomainAccountUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateDomainAccount(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -193,10 +193,10 @@ func resourceDomainAccountDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDomainAccountVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteDomainAccount(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -244,25 +244,7 @@ func searchResourceDomainAccount(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx,
-		"/domains/"+domainID+"/accounts/?q=account_name="+accountName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonDomainAccount
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/domains/"+domainID+"/accounts/", "account_name", accountName, m)
 }
 
 func addDomainAccount(
@@ -279,7 +261,7 @@ func addDomainAccount(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -299,7 +281,7 @@ func updateDomainAccount(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -315,7 +297,7 @@ func deleteDomainAccount(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -359,11 +341,13 @@ func readDomainAccountOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -20,6 +20,7 @@ func resourceDomainAccountCredential() *schema.Resource {
 		ReadContext:   resourceDomainAccountCredentialRead,
 		UpdateContext: resourceDomainAccountCredentialUpdate,
 		DeleteContext: resourceDomainAccountCredentialDelete,
+		CustomizeDiff: credentialRotationCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceDomainAccountCredentialImport,
 		},
@@ -57,10 +58,57 @@ func resourceDomainAccountCredential() *schema.Resource {
 				Sensitive: true,
 				ForceNew:  true,
 			},
+			"passphrase_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"passphrase"},
+				RequiredWith:  []string{"private_key_wo"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"password"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+			},
+			"private_key_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"private_key"},
+			},
+			"private_key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"private_key_wo"},
+			},
 			"public_key": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: credentialFingerprintDescription,
+			},
+			"ignore_rotation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: credentialIgnoreRotationDescription,
+			},
+			"rotated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"propagate_credential_change": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -82,43 +130,43 @@ func resourceDomainAccountCredentialCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDomainAccountCredentialVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfgDomain, err := readDomainOptions(ctx, d.Get("domain_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgDomain.ID == "" {
-		return diag.FromErr(fmt.Errorf("domain_id with ID %s doesn't exists", d.Get("domain_id").(string)))
+		return diagFromErr(fmt.Errorf("domain_id with ID %s doesn't exists", d.Get("domain_id").(string)))
 	}
 	cfgAccount, err := readDomainAccountOptions(ctx, d.Get("domain_id").(string), d.Get("account_id").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfgAccount.ID == "" {
-		return diag.FromErr(fmt.Errorf("account_id with ID %s on domain_id %s doesn't exists",
+		return diagFromErr(fmt.Errorf("account_id with ID %s on domain_id %s doesn't exists",
 			d.Get("account_id").(string), d.Get("domain_id").(string)))
 	}
 	_, ex, err := searchResourceDomainAccountCredential(ctx,
 		d.Get("domain_id").(string), d.Get("account_id").(string), d.Get("type").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("credential type %s on account_id %s, domain_id %s already exists",
+		return diagFromErr(fmt.Errorf("credential type %s on account_id %s, domain_id %s already exists",
 			d.Get("type").(string), d.Get("account_id").(string), d.Get("domain_id").(string)))
 	}
 	err = addDomainAccountCredential(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceDomainAccountCredential(ctx,
 		d.Get("domain_id").(string), d.Get("account_id").(string), d.Get("type").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf(
+		return diagFromErr(fmt.Errorf(
 			"credential type %s on account_id %s, domain_id %s not found after POST",
 			d.Get("type").(string), d.Get("account_id").(string), d.Get("domain_id").(string)))
 	}
@@ -132,12 +180,12 @@ func resourceDomainAccountCredentialRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDomainAccountCredentialVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readDomainAccountCredentialOptions(ctx,
 		d.Get("domain_id").(string), d.Get("account_id").(string), d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -154,10 +202,13 @@ func resourceDomainAccountCredentialUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceDomainAccountCredentialVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
-	if err := updateDomainAccountCredential(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+	if d.HasChangeExcept("ignore_rotation") {
+		if err := updateDomainAccountCredential(ctx, d, m); err != nil {
+			return diagFromErr(err)
+		}
+		resetCredentialRotation(d)
 	}
 	d.Partial(false)
 
@@ -169,10 +220,10 @@ func resourceDomainAccountCredentialDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceDomainAccountCredentialVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteDomainAccountCredential(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -231,7 +282,7 @@ func searchResourceDomainAccountCredential(
 		return "", false, err
 	}
 	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		return "", false, newAPIError(code, body)
 	}
 	var results []jsonCredential
 	err = json.Unmarshal([]byte(body), &results)
@@ -261,7 +312,7 @@ func addDomainAccountCredential(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	if propagate {
@@ -275,7 +326,7 @@ func addDomainAccountCredential(
 			return err
 		}
 		if code != http.StatusOK && code != http.StatusNoContent {
-			return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+			return newAPIError(code, body)
 		}
 	}
 
@@ -321,7 +372,7 @@ func updateDomainAccountCredential(
 	}
 
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("API didn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -338,7 +389,7 @@ func deleteDomainAccountCredential(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -353,7 +404,7 @@ func prepareDomainAccountCredentialJSON(
 
 	if propagate {
 		// Only include the password key
-		jsonData.Password = d.Get("password").(string)
+		jsonData.Password = secretString(d, "password")
 		if propagateAdd {
 			jsonData.Type = d.Get("type").(string)
 		}
@@ -363,10 +414,10 @@ func prepareDomainAccountCredentialJSON(
 
 		switch jsonData.Type {
 		case "password":
-			jsonData.Password = d.Get("password").(string)
+			jsonData.Password = secretString(d, "password")
 		case "ssh_key":
-			jsonData.PrivateKey = d.Get("private_key").(string)
-			jsonData.Passphrase = d.Get("passphrase").(string)
+			jsonData.PrivateKey = secretString(d, "private_key")
+			jsonData.Passphrase = secretString(d, "passphrase")
 		}
 	}
 
@@ -386,11 +437,13 @@ func readDomainAccountCredentialOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -418,4 +471,5 @@ func fillDomainAccountCredential(d *schema.ResourceData, jsonData jsonCredential
 	if tfErr := d.Set("public_key", jsonData.PublicKey); tfErr != nil {
 		panic(tfErr)
 	}
+	fillCredentialRotation(d, jsonData)
 }
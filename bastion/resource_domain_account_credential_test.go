@@ -2,6 +2,7 @@ package bastion_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -124,3 +125,190 @@ resource "tls_private_key" "testacc_DomainAccountCred" {
 }
 `
 }
+
+func TestAccResourceDomainAccountCred_writeOnly(t *testing.T) {
+	resourceName := "wallix-bastion_domain_account_credential.testacc_DomainAccountCredWriteOnly"
+	target := "testacc_DomainAccountCredWriteOnly_Admin@testacc_DomainAccountCredWriteOnly"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckTerraformVersion(t, "v1.11.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDomainAccountCredWriteOnly("aPassword_1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "password"),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckNoResourceAttr(resourceName+"2", "private_key"),
+					resource.TestCheckNoResourceAttr(resourceName+"2", "private_key_wo"),
+					resource.TestCheckNoResourceAttr(resourceName+"2", "passphrase_wo"),
+					testAccCheckFakeBastionCredential(target, "password", "password", "aPassword_1"),
+					testAccCheckFakeBastionCredential(target, "ssh_key", "private_key", "aPrivateKey"),
+					testAccCheckFakeBastionCredential(target, "ssh_key", "passphrase", "aPassphrase"),
+				),
+			},
+			{
+				Config: testAccResourceDomainAccountCredWriteOnly("aPassword_2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "2"),
+					testAccCheckFakeBastionCredential(target, "password", "password", "aPassword_2"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccResourceDomainAccountCredWriteOnly(password string, version int) string {
+	return fmt.Sprintf(`
+resource "wallix-bastion_domain" "testacc_DomainAccountCredWriteOnly" {
+  domain_name = "testacc_DomainAccountCredWriteOnly"
+}
+resource "wallix-bastion_domain_account" "testacc_DomainAccountCredWriteOnly" {
+  domain_id     = wallix-bastion_domain.testacc_DomainAccountCredWriteOnly.id
+  account_name  = "testacc_DomainAccountCredWriteOnly_Admin"
+  account_login = "admin"
+}
+resource "wallix-bastion_domain_account_credential" "testacc_DomainAccountCredWriteOnly" {
+  domain_id           = wallix-bastion_domain.testacc_DomainAccountCredWriteOnly.id
+  account_id          = wallix-bastion_domain_account.testacc_DomainAccountCredWriteOnly.id
+  type                = "password"
+  password_wo         = %q
+  password_wo_version = %d
+}
+resource "wallix-bastion_domain_account_credential" "testacc_DomainAccountCredWriteOnly2" {
+  domain_id              = wallix-bastion_domain.testacc_DomainAccountCredWriteOnly.id
+  account_id             = wallix-bastion_domain_account.testacc_DomainAccountCredWriteOnly.id
+  type                   = "ssh_key"
+  private_key_wo         = "aPrivateKey"
+  private_key_wo_version = 1
+  passphrase_wo          = "aPassphrase"
+}
+`, password, version)
+}
+
+func TestAccResourceDomainAccountCred_rotation(t *testing.T) {
+	resourceName := "wallix-bastion_domain_account_credential.testacc_DomainAccountCredRotation"
+	target := "testacc_DomainAccountCredRotation_Admin@testacc_DomainAccountCredRotation"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if testAccFakeBastion == nil {
+				t.Skip("rotating a credential outside of Terraform requires the fake bastion")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDomainAccountCredRotation(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "fingerprint", regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, "rotated", "false"),
+				),
+			},
+			{
+				PreConfig:          testAccFakeBastionSetCredential(t, target, "ssh_key", "public_key", "ssh-rsa AAAArotated"),
+				Config:             testAccResourceDomainAccountCredRotation(false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceDomainAccountCredRotation(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotated", "false"),
+					resource.TestMatchResourceAttr(resourceName, "public_key", regexp.MustCompile(`^ssh-ed25519 `)),
+					testAccCheckFakeBastionCredential(target, "ssh_key", "private_key", "aPrivateKey"),
+				),
+			},
+			{
+				Config: testAccResourceDomainAccountCredRotation(true),
+			},
+			{
+				PreConfig: testAccFakeBastionSetCredential(t, target, "ssh_key", "public_key", "ssh-rsa AAAArotated"),
+				Config:    testAccResourceDomainAccountCredRotation(true),
+				PlanOnly:  true,
+			},
+			{
+				Config: testAccResourceDomainAccountCredRotation(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotated", "false"),
+					resource.TestCheckResourceAttr(resourceName, "public_key", "ssh-rsa AAAArotated"),
+					testAccCheckFakeBastionCredential(target, "ssh_key", "public_key", "ssh-rsa AAAArotated"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+// TestAccResourceDomainAccountCred_passwordRotation checks the limit of the
+// rotation detection: the API reports nothing that changes with a password.
+func TestAccResourceDomainAccountCred_passwordRotation(t *testing.T) {
+	target := "testacc_DomainAccountCredPasswordRotation_Admin@testacc_DomainAccountCredPasswordRotation"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if testAccFakeBastion == nil {
+				t.Skip("rotating a credential outside of Terraform requires the fake bastion")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDomainAccountCredPasswordRotation(false),
+			},
+			{
+				PreConfig: testAccFakeBastionSetCredential(t, target, "password", "password", "aRotatedPassword"),
+				Config:    testAccResourceDomainAccountCredPasswordRotation(false),
+				PlanOnly:  true,
+			},
+			{
+				Config:      testAccResourceDomainAccountCredPasswordRotation(true),
+				ExpectError: regexp.MustCompile(`ignore_rotation is only available with type ssh_key`),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccResourceDomainAccountCredRotation(ignoreRotation bool) string {
+	return fmt.Sprintf(`
+resource "wallix-bastion_domain" "testacc_DomainAccountCredRotation" {
+  domain_name = "testacc_DomainAccountCredRotation"
+}
+resource "wallix-bastion_domain_account" "testacc_DomainAccountCredRotation" {
+  domain_id     = wallix-bastion_domain.testacc_DomainAccountCredRotation.id
+  account_name  = "testacc_DomainAccountCredRotation_Admin"
+  account_login = "admin"
+}
+resource "wallix-bastion_domain_account_credential" "testacc_DomainAccountCredRotation" {
+  domain_id       = wallix-bastion_domain.testacc_DomainAccountCredRotation.id
+  account_id      = wallix-bastion_domain_account.testacc_DomainAccountCredRotation.id
+  type            = "ssh_key"
+  private_key     = "aPrivateKey"
+  ignore_rotation = %t
+}
+`, ignoreRotation)
+}
+
+func testAccResourceDomainAccountCredPasswordRotation(ignoreRotation bool) string {
+	return fmt.Sprintf(`
+resource "wallix-bastion_domain" "testacc_DomainAccountCredPasswordRotation" {
+  domain_name = "testacc_DomainAccountCredPasswordRotation"
+}
+resource "wallix-bastion_domain_account" "testacc_DomainAccountCredPasswordRotation" {
+  domain_id     = wallix-bastion_domain.testacc_DomainAccountCredPasswordRotation.id
+  account_name  = "testacc_DomainAccountCredPasswordRotation_Admin"
+  account_login = "admin"
+}
+resource "wallix-bastion_domain_account_credential" "testacc_DomainAccountCredPasswordRotation" {
+  domain_id       = wallix-bastion_domain.testacc_DomainAccountCredPasswordRotation.id
+  account_id      = wallix-bastion_domain_account.testacc_DomainAccountCredPasswordRotation.id
+  type            = "password"
+  password        = "aPassword"
+  ignore_rotation = %t
+}
+`, ignoreRotation)
+}
@@ -76,6 +76,11 @@ resource "wallix-bastion_domain" "testacc_Domain" {
 }
 resource "wallix-bastion_domain" "testacc_Domain2" {
   domain_name = "testacc_Domain2"
+
+  timeouts {
+    create = "5m"
+    read   = "1m"
+  }
 }
 resource "tls_private_key" "testacc_Domain" {
   algorithm = "RSA"
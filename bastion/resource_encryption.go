@@ -51,13 +51,13 @@ func resourceEncryptionCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceEncryptionVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Add encryption
 	err := addEncryption(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Set a static ID since the API doesn't return one
@@ -66,7 +66,7 @@ func resourceEncryptionCreate(
 	// Set persistent attributes
 	err = d.Set("new_passphrase", d.Get("new_passphrase").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceEncryptionRead(ctx, d, m)
@@ -77,12 +77,12 @@ func resourceEncryptionRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceEncryptionVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	// Verify existence
 	exists, err := verifyEncryption(ctx, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !exists {
 		// Clear the resource ID if it no longer exists
@@ -93,7 +93,7 @@ func resourceEncryptionRead(
 	d.SetId("encryption")
 	err = d.Set("new_passphrase", d.Get("new_passphrase").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -105,7 +105,7 @@ func resourceEncryptionUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceEncryptionVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.Partial(true)
@@ -113,12 +113,12 @@ func resourceEncryptionUpdate(
 	// Update encryption
 	if d.HasChange("current_passphrase") || d.HasChange("new_passphrase") {
 		if err := updateEncryption(ctx, d, m); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if d.HasChange("new_passphrase") {
 			err := d.Set("new_passphrase", d.Get("new_passphrase"))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -138,7 +138,7 @@ func addEncryption(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("API didn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -154,7 +154,7 @@ func updateEncryption(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("API didn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -179,7 +179,7 @@ func verifyEncryption(
 	}
 
 	if code != http.StatusOK {
-		return false, fmt.Errorf("API didn't return OK: %d with body:\n%s", code, body)
+		return false, newAPIError(code, body)
 	}
 
 	// Check if encryption exists
@@ -150,29 +150,29 @@ func resourceExternalAuthLdapCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceExternalAuthLdapVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceExternalAuthLdap(ctx, d.Get("authentication_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("authentication_name %s already exists", d.Get("authentication_name").(string)))
+		return diagFromErr(fmt.Errorf("authentication_name %s already exists", d.Get("authentication_name").(string)))
 	}
 	if !d.Get("is_anonymous_access").(bool) && (d.Get("login").(string) == "" || d.Get("password").(string) == "") {
-		return diag.FromErr(fmt.Errorf("missing 'login' and/or 'password' on "+
+		return diagFromErr(fmt.Errorf("missing 'login' and/or 'password' on "+
 			"externalauth_ldap %s", d.Get("authentication_name").(string)))
 	}
 	err = addExternalAuthLdap(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceExternalAuthLdap(ctx, d.Get("authentication_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("authentication_name %s not found after POST", d.Get("authentication_name").(string)))
+		return diagFromErr(fmt.Errorf("authentication_name %s not found after POST", d.Get("authentication_name").(string)))
 	}
 	d.SetId(id)
 
@@ -184,11 +184,11 @@ func resourceExternalAuthLdapRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceExternalAuthLdapVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readExternalAuthLdapOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -205,14 +205,14 @@ func resourceExternalAuthLdapUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceExternalAuthLdapVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !d.Get("is_anonymous_access").(bool) && (d.Get("login").(string) == "" || d.Get("password").(string) == "") {
-		return diag.FromErr(fmt.Errorf("missing 'login' and/or 'password' on "+
+		return diagFromErr(fmt.Errorf("missing 'login' and/or 'password' on "+
 			"externalauth_ldap %s", d.Get("authentication_name").(string)))
 	}
 	if err := updateExternalAuthLdap(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -224,10 +224,10 @@ func resourceExternalAuthLdapDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceExternalAuthLdapVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteExternalAuthLdap(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -267,24 +267,7 @@ func searchResourceExternalAuthLdap(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/externalauths/?q=authentication_name="+authenticationName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonExternalAuthLdap
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/externalauths/", "authentication_name", authenticationName, m)
 }
 
 func addExternalAuthLdap(
@@ -297,7 +280,7 @@ func addExternalAuthLdap(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -313,7 +296,7 @@ func updateExternalAuthLdap(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -328,7 +311,7 @@ func deleteExternalAuthLdap(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -371,11 +354,13 @@ func readExternalAuthLdapOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 
 	err = json.Unmarshal([]byte(body), &result)
@@ -48,9 +48,21 @@ func resourceExternalAuthRadius() *schema.Resource {
 				ValidateFunc: validation.IntBetween(1, 65535),
 			},
 			"secret": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"secret", "secret_wo"},
+			},
+			"secret_wo": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
+				WriteOnly: true,
+			},
+			"secret_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"secret_wo"},
 			},
 			"timeout": {
 				Type:     schema.TypeFloat,
@@ -81,25 +93,25 @@ func resourceExternalAuthRadiusCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceExternalAuthRadiusVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceExternalAuthRadius(ctx, d.Get("authentication_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("authentication_name %s already exists", d.Get("authentication_name").(string)))
+		return diagFromErr(fmt.Errorf("authentication_name %s already exists", d.Get("authentication_name").(string)))
 	}
 	err = addExternalAuthRadius(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceExternalAuthRadius(ctx, d.Get("authentication_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("authentication_name %s not found after POST", d.Get("authentication_name").(string)))
+		return diagFromErr(fmt.Errorf("authentication_name %s not found after POST", d.Get("authentication_name").(string)))
 	}
 	d.SetId(id)
 
@@ -111,11 +123,11 @@ func resourceExternalAuthRadiusRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceExternalAuthRadiusVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readExternalAuthRadiusOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -132,10 +144,10 @@ func resourceExternalAuthRadiusUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceExternalAuthRadiusVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateExternalAuthRadius(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -147,10 +159,10 @@ func resourceExternalAuthRadiusDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceExternalAuthRadiusVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteExternalAuthRadius(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -190,24 +202,7 @@ func searchResourceExternalAuthRadius(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/externalauths/?q=authentication_name="+authenticationName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonExternalAuthRadius
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/externalauths/", "authentication_name", authenticationName, m)
 }
 
 func addExternalAuthRadius(
@@ -220,7 +215,7 @@ func addExternalAuthRadius(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -236,7 +231,7 @@ func updateExternalAuthRadius(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -251,7 +246,7 @@ func deleteExternalAuthRadius(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -262,7 +257,7 @@ func prepareExternalAuthRadiusJSON(d *schema.ResourceData) jsonExternalAuthRadiu
 		AuthenticationName:   d.Get("authentication_name").(string),
 		Host:                 d.Get("host").(string),
 		Port:                 d.Get("port").(int),
-		Secret:               d.Get("secret").(string),
+		Secret:               secretString(d, "secret"),
 		Timeout:              d.Get("timeout").(float64),
 		Description:          d.Get("description").(string),
 		UsePrimaryAuthDomain: d.Get("use_primary_auth_domain").(bool),
@@ -281,11 +276,13 @@ func readExternalAuthRadiusOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 
 	err = json.Unmarshal([]byte(body), &result)
@@ -1,6 +1,7 @@
 package bastion_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -57,3 +58,47 @@ resource "wallix-bastion_externalauth_radius" "testacc_ExternalAuthRadius" {
 }
 `
 }
+
+func TestAccResourceExternalAuthRadius_writeOnly(t *testing.T) {
+	resourceName := "wallix-bastion_externalauth_radius.testacc_ExternalAuthRadiusWriteOnly"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckTerraformVersion(t, "v1.11.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceExternalAuthRadiusWriteOnly("aSecret", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "secret"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_wo"),
+					testAccCheckFakeBastionField("/externalauths/",
+						"testacc_ExternalAuthRadiusWriteOnly", "secret", "aSecret"),
+				),
+			},
+			{
+				Config: testAccResourceExternalAuthRadiusWriteOnly("aNewSecret", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "secret_wo"),
+					testAccCheckFakeBastionField("/externalauths/",
+						"testacc_ExternalAuthRadiusWriteOnly", "secret", "aNewSecret"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccResourceExternalAuthRadiusWriteOnly(secret string, version int) string {
+	return fmt.Sprintf(`
+resource "wallix-bastion_externalauth_radius" "testacc_ExternalAuthRadiusWriteOnly" {
+  authentication_name = "testacc_ExternalAuthRadiusWriteOnly"
+  host                = "server1"
+  port                = 1813
+  secret_wo           = %q
+  secret_wo_version   = %d
+  timeout             = 10
+}
+`, secret, version)
+}
@@ -47,9 +47,21 @@ func resourceExternalAuthTacacs() *schema.Resource {
 				ValidateFunc: validation.IntBetween(1, 65535),
 			},
 			"secret": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"secret", "secret_wo"},
+			},
+			"secret_wo": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
+				WriteOnly: true,
+			},
+			"secret_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"secret_wo"},
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -76,25 +88,25 @@ func resourceExternalAuthTacacsCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceExternalAuthTacacsVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_, ex, err := searchResourceExternalAuthTacacs(ctx, d.Get("authentication_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("authentication_name %s already exists", d.Get("authentication_name").(string)))
+		return diagFromErr(fmt.Errorf("authentication_name %s already exists", d.Get("authentication_name").(string)))
 	}
 	err = addExternalAuthTacacs(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	id, ex, err := searchResourceExternalAuthTacacs(ctx, d.Get("authentication_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("authentication_name %s not found after POST", d.Get("authentication_name").(string)))
+		return diagFromErr(fmt.Errorf("authentication_name %s not found after POST", d.Get("authentication_name").(string)))
 	}
 	d.SetId(id)
 
@@ -106,11 +118,11 @@ func resourceExternalAuthTacacsRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceExternalAuthTacacsVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readExternalAuthTacacsOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.ID == "" {
 		d.SetId("")
@@ -127,10 +139,10 @@ func resourceExternalAuthTacacsUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceExternalAuthTacacsVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateExternalAuthTacacs(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -142,10 +154,10 @@ func resourceExternalAuthTacacsDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceExternalAuthTacacsVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteExternalAuthTacacs(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -185,24 +197,7 @@ func searchResourceExternalAuthTacacs(
 ) (
 	string, bool, error,
 ) {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/externalauths/?q=authentication_name="+authenticationName, http.MethodGet, nil)
-	if err != nil {
-		return "", false, err
-	}
-	if code != http.StatusOK {
-		return "", false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
-	}
-	var results []jsonExternalAuthTacacs
-	err = json.Unmarshal([]byte(body), &results)
-	if err != nil {
-		return "", false, fmt.Errorf("unmarshaling json: %w", err)
-	}
-	if len(results) == 1 {
-		return results[0].ID, true, nil
-	}
-
-	return "", false, nil
+	return searchResourceID(ctx, "/externalauths/", "authentication_name", authenticationName, m)
 }
 
 func addExternalAuthTacacs(
@@ -215,7 +210,7 @@ func addExternalAuthTacacs(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -231,7 +226,7 @@ func updateExternalAuthTacacs(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -246,7 +241,7 @@ func deleteExternalAuthTacacs(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -257,7 +252,7 @@ func prepareExternalAuthTacacsJSON(d *schema.ResourceData) jsonExternalAuthTacac
 		AuthenticationName:   d.Get("authentication_name").(string),
 		Host:                 d.Get("host").(string),
 		Port:                 d.Get("port").(int),
-		Secret:               d.Get("secret").(string),
+		Secret:               secretString(d, "secret"),
 		Description:          d.Get("description").(string),
 		UsePrimaryAuthDomain: d.Get("use_primary_auth_domain").(bool),
 		Type:                 "TACACS+",
@@ -275,11 +270,13 @@ func readExternalAuthTacacsOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 
 	err = json.Unmarshal([]byte(body), &result)
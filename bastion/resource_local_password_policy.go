@@ -0,0 +1,427 @@
+package bastion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// localPasswordPolicyDefaultName is the name of the built-in policy, which
+// can't be removed from the bastion.
+const localPasswordPolicyDefaultName = "default"
+
+type jsonLocalPasswordPolicy struct {
+	AllowSameUserAndPassword *bool     `json:"allow_same_user_and_password,omitempty"`
+	ID                       string    `json:"id,omitempty"`
+	PasswordPolicyName       string    `json:"password_policy_name"`
+	PasswordExpiration       *int      `json:"password_expiration,omitempty"`
+	PasswordWarningDays      *int      `json:"password_warning_days,omitempty"`
+	PasswordMinLength        *int      `json:"password_min_length,omitempty"`
+	PasswordMinLowerChars    *int      `json:"password_min_lower_chars,omitempty"`
+	PasswordMinUpperChars    *int      `json:"password_min_upper_chars,omitempty"`
+	PasswordMinDigitChars    *int      `json:"password_min_digit_chars,omitempty"`
+	PasswordMinSpecialChars  *int      `json:"password_min_special_chars,omitempty"`
+	LastPasswordsToReject    *int      `json:"last_passwords_to_reject,omitempty"`
+	MaxAuthFailures          *int      `json:"max_auth_failures,omitempty"`
+	SSHRsaMinLength          *int      `json:"ssh_rsa_min_length,omitempty"`
+	ForbiddenPasswords       *[]string `json:"forbidden_passwords,omitempty"`
+	SSHKeyAlgosAllowed       *[]string `json:"ssh_key_algos_allowed,omitempty"`
+}
+
+func resourceLocalPasswordPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLocalPasswordPolicyCreate,
+		ReadContext:   resourceLocalPasswordPolicyRead,
+		UpdateContext: resourceLocalPasswordPolicyUpdate,
+		DeleteContext: resourceLocalPasswordPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceLocalPasswordPolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"password_policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"allow_same_user_and_password": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"forbidden_passwords": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"last_passwords_to_reject": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"max_auth_failures": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"password_expiration": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"password_min_digit_chars": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"password_min_length": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"password_min_lower_chars": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"password_min_special_chars": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"password_min_upper_chars": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"password_warning_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"ssh_key_algos_allowed": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ssh_rsa_min_length": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLocalPasswordPolicyVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("resource wallix-bastion_local_password_policy not available with api version %s", version)
+}
+
+func resourceLocalPasswordPolicyCreate(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := resourceLocalPasswordPolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	passwordPolicyName := d.Get("password_policy_name").(string)
+	id, ex, err := searchResourceLocalPasswordPolicy(ctx, passwordPolicyName, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	// the built-in policy always exists, so creating it takes it over
+	if passwordPolicyName == localPasswordPolicyDefaultName && ex {
+		d.SetId(id)
+		if err := updateLocalPasswordPolicy(ctx, d, m); err != nil {
+			d.SetId("")
+
+			return diagFromErr(err)
+		}
+
+		return resourceLocalPasswordPolicyRead(ctx, d, m)
+	}
+	if ex {
+		return diagFromErr(fmt.Errorf("password_policy_name %s already exists", passwordPolicyName))
+	}
+	err = addLocalPasswordPolicy(ctx, d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err = searchResourceLocalPasswordPolicy(ctx, passwordPolicyName, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("password_policy_name %s not found after POST", passwordPolicyName))
+	}
+	d.SetId(id)
+
+	return resourceLocalPasswordPolicyRead(ctx, d, m)
+}
+
+func resourceLocalPasswordPolicyRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := resourceLocalPasswordPolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	cfg, err := readLocalPasswordPolicyOptions(ctx, d.Id(), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		d.SetId("")
+	} else {
+		fillLocalPasswordPolicy(d, cfg)
+	}
+
+	return nil
+}
+
+func resourceLocalPasswordPolicyUpdate(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	d.Partial(true)
+	c := m.(*Client)
+	if err := resourceLocalPasswordPolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	if err := updateLocalPasswordPolicy(ctx, d, m); err != nil {
+		return diagFromErr(err)
+	}
+	d.Partial(false)
+
+	return resourceLocalPasswordPolicyRead(ctx, d, m)
+}
+
+func resourceLocalPasswordPolicyDelete(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := resourceLocalPasswordPolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	// the built-in policy can't be deleted, so it's only removed from the state
+	if d.Get("password_policy_name").(string) == localPasswordPolicyDefaultName {
+		return nil
+	}
+	if err := deleteLocalPasswordPolicy(ctx, d, m); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceLocalPasswordPolicyImport(
+	d *schema.ResourceData, m interface{},
+) (
+	[]*schema.ResourceData, error,
+) {
+	ctx := context.Background()
+	c := m.(*Client)
+	if err := resourceLocalPasswordPolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return nil, err
+	}
+	id, ex, err := searchResourceLocalPasswordPolicy(ctx, d.Id(), m)
+	if err != nil {
+		return nil, err
+	}
+	if !ex {
+		return nil, fmt.Errorf("don't find password_policy_name with id %s (id must be <password_policy_name>)", d.Id())
+	}
+	cfg, err := readLocalPasswordPolicyOptions(ctx, id, m)
+	if err != nil {
+		return nil, err
+	}
+	fillLocalPasswordPolicy(d, cfg)
+	result := make([]*schema.ResourceData, 1)
+	d.SetId(id)
+	result[0] = d
+
+	return result, nil
+}
+
+func searchResourceLocalPasswordPolicy(
+	ctx context.Context, passwordPolicyName string, m interface{},
+) (
+	string, bool, error,
+) {
+	return searchResourceID(ctx, "/localpasswordpolicies/", "password_policy_name", passwordPolicyName, m)
+}
+
+func addLocalPasswordPolicy(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) error {
+	c := m.(*Client)
+	jsonData := prepareLocalPasswordPolicyJSON(d)
+	body, code, err := c.newRequest(ctx, "/localpasswordpolicies/", http.MethodPost, jsonData)
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK && code != http.StatusNoContent {
+		return newAPIError(code, body)
+	}
+
+	return nil
+}
+
+func updateLocalPasswordPolicy(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) error {
+	c := m.(*Client)
+	jsonData := prepareLocalPasswordPolicyJSON(d)
+	body, code, err := c.newRequest(ctx, "/localpasswordpolicies/"+d.Id(), http.MethodPut, jsonData)
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK && code != http.StatusNoContent {
+		return newAPIError(code, body)
+	}
+
+	return nil
+}
+
+func deleteLocalPasswordPolicy(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) error {
+	c := m.(*Client)
+	body, code, err := c.newRequest(ctx, "/localpasswordpolicies/"+d.Id(), http.MethodDelete, nil)
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK && code != http.StatusNoContent {
+		return newAPIError(code, body)
+	}
+
+	return nil
+}
+
+// prepareLocalPasswordPolicyJSON sends the settings known in the plan, so the
+// bastion keeps its own value for a setting not set on creation.
+func prepareLocalPasswordPolicyJSON(d *schema.ResourceData) jsonLocalPasswordPolicy {
+	jsonData := jsonLocalPasswordPolicy{
+		PasswordPolicyName:      d.Get("password_policy_name").(string),
+		PasswordExpiration:      localPasswordPolicyPlannedInt(d, "password_expiration"),
+		PasswordWarningDays:     localPasswordPolicyPlannedInt(d, "password_warning_days"),
+		PasswordMinLength:       localPasswordPolicyPlannedInt(d, "password_min_length"),
+		PasswordMinLowerChars:   localPasswordPolicyPlannedInt(d, "password_min_lower_chars"),
+		PasswordMinUpperChars:   localPasswordPolicyPlannedInt(d, "password_min_upper_chars"),
+		PasswordMinDigitChars:   localPasswordPolicyPlannedInt(d, "password_min_digit_chars"),
+		PasswordMinSpecialChars: localPasswordPolicyPlannedInt(d, "password_min_special_chars"),
+		LastPasswordsToReject:   localPasswordPolicyPlannedInt(d, "last_passwords_to_reject"),
+		MaxAuthFailures:         localPasswordPolicyPlannedInt(d, "max_auth_failures"),
+		SSHRsaMinLength:         localPasswordPolicyPlannedInt(d, "ssh_rsa_min_length"),
+		ForbiddenPasswords:      localPasswordPolicyPlannedSet(d, "forbidden_passwords"),
+		SSHKeyAlgosAllowed:      localPasswordPolicyPlannedSet(d, "ssh_key_algos_allowed"),
+	}
+	if d.GetRawPlan().GetAttr("allow_same_user_and_password").IsKnown() {
+		v := d.Get("allow_same_user_and_password").(bool)
+		jsonData.AllowSameUserAndPassword = &v
+	}
+
+	return jsonData
+}
+
+func localPasswordPolicyPlannedInt(d *schema.ResourceData, key string) *int {
+	if !d.GetRawPlan().GetAttr(key).IsKnown() {
+		return nil
+	}
+	v := d.Get(key).(int)
+
+	return &v
+}
+
+func localPasswordPolicyPlannedSet(d *schema.ResourceData, key string) *[]string {
+	if !d.GetRawPlan().GetAttr(key).IsKnown() {
+		return nil
+	}
+	v := make([]string, 0)
+	for _, e := range d.Get(key).(*schema.Set).List() {
+		v = append(v, e.(string))
+	}
+
+	return &v
+}
+
+func readLocalPasswordPolicyOptions(
+	ctx context.Context, passwordPolicyID string, m interface{},
+) (
+	jsonLocalPasswordPolicy, error,
+) {
+	c := m.(*Client)
+	var result jsonLocalPasswordPolicy
+	body, code, err := c.newRequest(ctx, "/localpasswordpolicies/"+passwordPolicyID, http.MethodGet, nil)
+	if err != nil {
+		return result, err
+	}
+	if code != http.StatusOK {
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
+	}
+	err = json.Unmarshal([]byte(body), &result)
+	if err != nil {
+		return result, fmt.Errorf("unmarshaling json: %w", err)
+	}
+
+	return result, nil
+}
+
+func fillLocalPasswordPolicy(d *schema.ResourceData, jsonData jsonLocalPasswordPolicy) {
+	if tfErr := d.Set("password_policy_name", jsonData.PasswordPolicyName); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("allow_same_user_and_password", jsonData.AllowSameUserAndPassword); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("password_expiration", jsonData.PasswordExpiration); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("password_warning_days", jsonData.PasswordWarningDays); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("password_min_length", jsonData.PasswordMinLength); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("password_min_lower_chars", jsonData.PasswordMinLowerChars); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("password_min_upper_chars", jsonData.PasswordMinUpperChars); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("password_min_digit_chars", jsonData.PasswordMinDigitChars); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("password_min_special_chars", jsonData.PasswordMinSpecialChars); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("last_passwords_to_reject", jsonData.LastPasswordsToReject); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("max_auth_failures", jsonData.MaxAuthFailures); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("ssh_rsa_min_length", jsonData.SSHRsaMinLength); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("forbidden_passwords", jsonData.ForbiddenPasswords); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("ssh_key_algos_allowed", jsonData.SSHKeyAlgosAllowed); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,167 @@
+package bastion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceLocalPasswordPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLocalPasswordPolicyCreate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicy",
+						"id"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicy",
+						"password_min_length", "12"),
+					testAccCheckFakeBastionField("/localpasswordpolicies/", "testacc_LocalPasswordPolicy",
+						"ssh_key_algos_allowed", "<nil>"),
+				),
+			},
+			{
+				Config: testAccResourceLocalPasswordPolicyUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicy",
+						"password_min_length", "16"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicy",
+						"forbidden_passwords.#", "2"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicy",
+						"ssh_key_algos_allowed.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceLocalPasswordPolicyUpdateUnset(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicy",
+						"password_min_length", "20"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicy",
+						"forbidden_passwords.#", "2"),
+					testAccCheckFakeBastionField("/localpasswordpolicies/", "testacc_LocalPasswordPolicy",
+						"ssh_key_algos_allowed", "[ssh-ed25519]"),
+				),
+			},
+			{
+				ResourceName:  "wallix-bastion_local_password_policy.testacc_LocalPasswordPolicy",
+				ImportState:   true,
+				ImportStateId: "testacc_LocalPasswordPolicy",
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func TestAccResourceLocalPasswordPolicy_default(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLocalPasswordPolicyDefault(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicyDefault",
+						"password_min_length", "14"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicyDefault",
+						"max_auth_failures", "5"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicyDefault",
+						"ssh_rsa_min_length", "3072"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicyDefault",
+						"forbidden_passwords.#", "1"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_local_password_policy.testacc_LocalPasswordPolicyDefault",
+						"ssh_key_algos_allowed.#", "3"),
+				),
+			},
+			{
+				ResourceName:  "wallix-bastion_local_password_policy.testacc_LocalPasswordPolicyDefault",
+				ImportState:   true,
+				ImportStateId: "default",
+			},
+			{
+				Config: testAccResourceLocalPasswordPolicyDefaultRemoved(),
+			},
+			{
+				Config: testAccResourceLocalPasswordPolicyDefaultRemoved(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_local_password_policy.testacc_LocalPasswordPolicyDefault",
+						"password_min_length", "14"),
+					resource.TestCheckResourceAttr(
+						"data.wallix-bastion_local_password_policy.testacc_LocalPasswordPolicyDefault",
+						"max_auth_failures", "5"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccResourceLocalPasswordPolicyCreate() string {
+	return `
+resource "wallix-bastion_local_password_policy" "testacc_LocalPasswordPolicy" {
+  password_policy_name = "testacc_LocalPasswordPolicy"
+  password_min_length  = 12
+}
+`
+}
+
+func testAccResourceLocalPasswordPolicyUpdate() string {
+	return `
+resource "wallix-bastion_local_password_policy" "testacc_LocalPasswordPolicy" {
+  password_policy_name       = "testacc_LocalPasswordPolicy"
+  password_expiration        = 90
+  password_warning_days      = 7
+  password_min_length        = 16
+  password_min_lower_chars   = 1
+  password_min_upper_chars   = 1
+  password_min_digit_chars   = 1
+  password_min_special_chars = 1
+  last_passwords_to_reject   = 3
+  max_auth_failures          = 5
+  forbidden_passwords        = ["password", "wallix"]
+  ssh_key_algos_allowed      = ["ssh-ed25519"]
+  ssh_rsa_min_length         = 4096
+}
+`
+}
+
+func testAccResourceLocalPasswordPolicyUpdateUnset() string {
+	return `
+resource "wallix-bastion_local_password_policy" "testacc_LocalPasswordPolicy" {
+  password_policy_name = "testacc_LocalPasswordPolicy"
+  password_min_length  = 20
+}
+`
+}
+
+func testAccResourceLocalPasswordPolicyDefault() string {
+	return `
+resource "wallix-bastion_local_password_policy" "testacc_LocalPasswordPolicyDefault" {
+  password_policy_name = "default"
+  password_min_length  = 14
+  max_auth_failures    = 5
+}
+`
+}
+
+func testAccResourceLocalPasswordPolicyDefaultRemoved() string {
+	return `
+data "wallix-bastion_local_password_policy" "testacc_LocalPasswordPolicyDefault" {
+  password_policy_name = "default"
+}
+`
+}
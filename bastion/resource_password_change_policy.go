@@ -0,0 +1,405 @@
+package bastion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type jsonPasswordChangePolicy struct {
+	ID                       string `json:"id,omitempty"`
+	PasswordChangePolicyName string `json:"password_change_policy_name"`
+	Description              string `json:"description"`
+	PasswordLength           int    `json:"password_length"`
+	SpecialChars             int    `json:"special_chars"`
+	LowerChars               int    `json:"lower_chars"`
+	UpperChars               int    `json:"upper_chars"`
+	DigitChars               int    `json:"digit_chars"`
+	ExcludeChars             string `json:"exclude_chars"`
+	SSHKeyType               string `json:"ssh_key_type"`
+	SSHKeySize               int    `json:"ssh_key_size"`
+	ChangePeriod             string `json:"change_period"`
+}
+
+// passwordChangePolicySSHKeySizes lists the sizes (in bits) that the bastion
+// can generate for each SSH key type.
+func passwordChangePolicySSHKeySizes() map[string][]int {
+	return map[string][]int{
+		"RSA":     {1024, 2048, 3072, 4096, 8192},
+		"DSA":     {1024},
+		"ECDSA":   {256, 384, 521},
+		"ED25519": {256},
+	}
+}
+
+// passwordChangePolicySSHKeyDefaultSizes lists the size (in bits) used for
+// each SSH key type when ssh_key_size isn't set.
+func passwordChangePolicySSHKeyDefaultSizes() map[string]int {
+	return map[string]int{
+		"RSA":     2048,
+		"DSA":     1024,
+		"ECDSA":   256,
+		"ED25519": 256,
+	}
+}
+
+func resourcePasswordChangePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePasswordChangePolicyCreate,
+		ReadContext:   resourcePasswordChangePolicyRead,
+		UpdateContext: resourcePasswordChangePolicyUpdate,
+		DeleteContext: resourcePasswordChangePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePasswordChangePolicyImport,
+		},
+		CustomizeDiff: resourcePasswordChangePolicyCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"password_change_policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      12,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"special_chars": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"lower_chars": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"upper_chars": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"digit_chars": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"exclude_chars": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ssh_key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RSA",
+				ValidateFunc: validation.StringInSlice([]string{"RSA", "DSA", "ECDSA", "ED25519"}, false),
+			},
+			"ssh_key_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"change_period": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(\S+ ){4}\S+$`),
+					"Must respect the cron format `minute hour day_of_month month day_of_week`"),
+			},
+		},
+	}
+}
+
+func resourcePasswordChangePolicyVersionCheck(version string) error {
+	if slices.Contains(defaultVersionsValid(), version) {
+		return nil
+	}
+
+	return fmt.Errorf("resource wallix-bastion_password_change_policy not available with api version %s", version)
+}
+
+func resourcePasswordChangePolicyCustomizeDiff(
+	_ context.Context, d *schema.ResourceDiff, _ interface{},
+) error {
+	if !d.NewValueKnown("ssh_key_type") {
+		return nil
+	}
+	sshKeyType := d.Get("ssh_key_type").(string)
+	sshKeySize := d.Get("ssh_key_size").(int)
+	// without ssh_key_size, use the default size of the type
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() || rawConfig.GetAttr("ssh_key_size").IsNull() {
+		defaultSize, ok := passwordChangePolicySSHKeyDefaultSizes()[sshKeyType]
+		if ok && (sshKeySize != defaultSize || !d.NewValueKnown("ssh_key_size")) {
+			return d.SetNew("ssh_key_size", defaultSize)
+		}
+
+		return nil
+	}
+	if !d.NewValueKnown("ssh_key_size") {
+		return nil
+	}
+	sizes, ok := passwordChangePolicySSHKeySizes()[sshKeyType]
+	if !ok || slices.Contains(sizes, sshKeySize) {
+		return nil
+	}
+
+	return fmt.Errorf("ssh_key_size %d not available with ssh_key_type %s (must be one of %v)",
+		sshKeySize, sshKeyType, sizes)
+}
+
+func resourcePasswordChangePolicyCreate(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := resourcePasswordChangePolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	_, ex, err := searchResourcePasswordChangePolicy(ctx, d.Get("password_change_policy_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if ex {
+		return diagFromErr(fmt.Errorf("password_change_policy_name %s already exists",
+			d.Get("password_change_policy_name").(string)))
+	}
+	err = addPasswordChangePolicy(ctx, d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id, ex, err := searchResourcePasswordChangePolicy(ctx, d.Get("password_change_policy_name").(string), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if !ex {
+		return diagFromErr(fmt.Errorf("password_change_policy_name %s not found after POST",
+			d.Get("password_change_policy_name").(string)))
+	}
+	d.SetId(id)
+
+	return resourcePasswordChangePolicyRead(ctx, d, m)
+}
+
+func resourcePasswordChangePolicyRead(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := resourcePasswordChangePolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	cfg, err := readPasswordChangePolicyOptions(ctx, d.Id(), m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if cfg.ID == "" {
+		d.SetId("")
+	} else {
+		fillPasswordChangePolicy(d, cfg)
+	}
+
+	return nil
+}
+
+func resourcePasswordChangePolicyUpdate(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	d.Partial(true)
+	c := m.(*Client)
+	if err := resourcePasswordChangePolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	if err := updatePasswordChangePolicy(ctx, d, m); err != nil {
+		return diagFromErr(err)
+	}
+	d.Partial(false)
+
+	return resourcePasswordChangePolicyRead(ctx, d, m)
+}
+
+func resourcePasswordChangePolicyDelete(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) diag.Diagnostics {
+	c := m.(*Client)
+	if err := resourcePasswordChangePolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return diagFromErr(err)
+	}
+	if err := deletePasswordChangePolicy(ctx, d, m); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePasswordChangePolicyImport(
+	d *schema.ResourceData, m interface{},
+) (
+	[]*schema.ResourceData, error,
+) {
+	ctx := context.Background()
+	c := m.(*Client)
+	if err := resourcePasswordChangePolicyVersionCheck(c.bastionAPIVersion); err != nil {
+		return nil, err
+	}
+	id, ex, err := searchResourcePasswordChangePolicy(ctx, d.Id(), m)
+	if err != nil {
+		return nil, err
+	}
+	if !ex {
+		return nil, fmt.Errorf("don't find password_change_policy_name with id %s "+
+			"(id must be <password_change_policy_name>)", d.Id())
+	}
+	cfg, err := readPasswordChangePolicyOptions(ctx, id, m)
+	if err != nil {
+		return nil, err
+	}
+	fillPasswordChangePolicy(d, cfg)
+	result := make([]*schema.ResourceData, 1)
+	d.SetId(id)
+	result[0] = d
+
+	return result, nil
+}
+
+func searchResourcePasswordChangePolicy(
+	ctx context.Context, passwordChangePolicyName string, m interface{},
+) (
+	string, bool, error,
+) {
+	return searchResourceID(ctx, "/passwordchangepolicies/", "password_change_policy_name", passwordChangePolicyName, m)
+}
+
+func addPasswordChangePolicy(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) error {
+	c := m.(*Client)
+	jsonData := preparePasswordChangePolicyJSON(d)
+	body, code, err := c.newRequest(ctx, "/passwordchangepolicies/", http.MethodPost, jsonData)
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK && code != http.StatusNoContent {
+		return newAPIError(code, body)
+	}
+
+	return nil
+}
+
+func updatePasswordChangePolicy(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) error {
+	c := m.(*Client)
+	jsonData := preparePasswordChangePolicyJSON(d)
+	body, code, err := c.newRequest(ctx, "/passwordchangepolicies/"+d.Id(), http.MethodPut, jsonData)
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK && code != http.StatusNoContent {
+		return newAPIError(code, body)
+	}
+
+	return nil
+}
+
+func deletePasswordChangePolicy(
+	ctx context.Context, d *schema.ResourceData, m interface{},
+) error {
+	c := m.(*Client)
+	body, code, err := c.newRequest(ctx, "/passwordchangepolicies/"+d.Id(), http.MethodDelete, nil)
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK && code != http.StatusNoContent {
+		return newAPIError(code, body)
+	}
+
+	return nil
+}
+
+func preparePasswordChangePolicyJSON(d *schema.ResourceData) jsonPasswordChangePolicy {
+	return jsonPasswordChangePolicy{
+		PasswordChangePolicyName: d.Get("password_change_policy_name").(string),
+		Description:              d.Get("description").(string),
+		PasswordLength:           d.Get("password_length").(int),
+		SpecialChars:             d.Get("special_chars").(int),
+		LowerChars:               d.Get("lower_chars").(int),
+		UpperChars:               d.Get("upper_chars").(int),
+		DigitChars:               d.Get("digit_chars").(int),
+		ExcludeChars:             d.Get("exclude_chars").(string),
+		SSHKeyType:               d.Get("ssh_key_type").(string),
+		SSHKeySize:               d.Get("ssh_key_size").(int),
+		ChangePeriod:             d.Get("change_period").(string),
+	}
+}
+
+func readPasswordChangePolicyOptions(
+	ctx context.Context, passwordChangePolicyID string, m interface{},
+) (
+	jsonPasswordChangePolicy, error,
+) {
+	c := m.(*Client)
+	var result jsonPasswordChangePolicy
+	body, code, err := c.newRequest(ctx, "/passwordchangepolicies/"+passwordChangePolicyID, http.MethodGet, nil)
+	if err != nil {
+		return result, err
+	}
+	if code != http.StatusOK {
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
+	}
+	err = json.Unmarshal([]byte(body), &result)
+	if err != nil {
+		return result, fmt.Errorf("unmarshaling json: %w", err)
+	}
+
+	return result, nil
+}
+
+func fillPasswordChangePolicy(d *schema.ResourceData, jsonData jsonPasswordChangePolicy) {
+	if tfErr := d.Set("password_change_policy_name", jsonData.PasswordChangePolicyName); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("description", jsonData.Description); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("password_length", jsonData.PasswordLength); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("special_chars", jsonData.SpecialChars); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("lower_chars", jsonData.LowerChars); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("upper_chars", jsonData.UpperChars); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("digit_chars", jsonData.DigitChars); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("exclude_chars", jsonData.ExcludeChars); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("ssh_key_type", jsonData.SSHKeyType); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("ssh_key_size", jsonData.SSHKeySize); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("change_period", jsonData.ChangePeriod); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,118 @@
+package bastion_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcePasswordChangePolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePasswordChangePolicyCreate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"wallix-bastion_password_change_policy.testacc_PasswordChangePolicy",
+						"id"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_password_change_policy.testacc_PasswordChangePolicy",
+						"ssh_key_type", "RSA"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_password_change_policy.testacc_PasswordChangePolicy",
+						"ssh_key_size", "2048"),
+				),
+			},
+			{
+				Config: testAccResourcePasswordChangePolicyUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_password_change_policy.testacc_PasswordChangePolicy",
+						"change_period", "0 2 * * 0"),
+					resource.TestCheckResourceAttrPair(
+						"wallix-bastion_domain.testacc_PasswordChangePolicy", "password_change_policy",
+						"wallix-bastion_password_change_policy.testacc_PasswordChangePolicy", "password_change_policy_name"),
+				),
+			},
+			{
+				ResourceName:  "wallix-bastion_password_change_policy.testacc_PasswordChangePolicy",
+				ImportState:   true,
+				ImportStateId: "testacc_PasswordChangePolicy",
+			},
+			{
+				Config: testAccResourcePasswordChangePolicyED25519(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_password_change_policy.testacc_PasswordChangePolicy",
+						"ssh_key_type", "ED25519"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_password_change_policy.testacc_PasswordChangePolicy",
+						"ssh_key_size", "256"),
+				),
+			},
+			{
+				Config:      testAccResourcePasswordChangePolicyBadSSHKey(),
+				ExpectError: regexp.MustCompile(`ssh_key_size 2048 not available with ssh_key_type ECDSA`),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccResourcePasswordChangePolicyCreate() string {
+	return `
+resource "wallix-bastion_password_change_policy" "testacc_PasswordChangePolicy" {
+  password_change_policy_name = "testacc_PasswordChangePolicy"
+}
+`
+}
+
+func testAccResourcePasswordChangePolicyUpdate() string {
+	return `
+resource "wallix-bastion_password_change_policy" "testacc_PasswordChangePolicy" {
+  password_change_policy_name = "testacc_PasswordChangePolicy"
+  description                 = "testacc PasswordChangePolicy"
+  password_length             = 20
+  special_chars               = 2
+  lower_chars                 = 2
+  upper_chars                 = 2
+  digit_chars                 = 2
+  exclude_chars               = "\"'"
+  ssh_key_type                = "ED25519"
+  ssh_key_size                = 256
+  change_period               = "0 2 * * 0"
+}
+resource "wallix-bastion_domain" "testacc_PasswordChangePolicy" {
+  domain_name            = "testacc_PasswordChangePolicy"
+  domain_real_name       = "testacc.passwordchangepolicy"
+  enable_password_change = true
+  password_change_policy = wallix-bastion_password_change_policy.testacc_PasswordChangePolicy.password_change_policy_name
+  password_change_plugin = "Unix"
+  password_change_plugin_parameters = jsonencode({
+    host : "192.0.2.1"
+  })
+}
+`
+}
+
+func testAccResourcePasswordChangePolicyED25519() string {
+	return `
+resource "wallix-bastion_password_change_policy" "testacc_PasswordChangePolicy" {
+  password_change_policy_name = "testacc_PasswordChangePolicy"
+  ssh_key_type                = "ED25519"
+}
+`
+}
+
+func testAccResourcePasswordChangePolicyBadSSHKey() string {
+	return `
+resource "wallix-bastion_password_change_policy" "testacc_PasswordChangePolicy" {
+  password_change_policy_name = "testacc_PasswordChangePolicy"
+  ssh_key_type                = "ECDSA"
+  ssh_key_size                = 2048
+}
+`
+}
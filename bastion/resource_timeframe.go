@@ -104,25 +104,25 @@ func resourceTimeframeCreate(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceTimeframeVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	ex, err := checkResourceTimeframeExits(ctx, d.Get("timeframe_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("timeframe_name %s already exists", d.Get("timeframe_name").(string)))
+		return diagFromErr(fmt.Errorf("timeframe_name %s already exists", d.Get("timeframe_name").(string)))
 	}
 	err = addTimeframe(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	ex, err = checkResourceTimeframeExits(ctx, d.Get("timeframe_name").(string), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if !ex {
-		return diag.FromErr(fmt.Errorf("timeframe_name %s not found after POST", d.Get("timeframe_name").(string)))
+		return diagFromErr(fmt.Errorf("timeframe_name %s not found after POST", d.Get("timeframe_name").(string)))
 	}
 	d.SetId(d.Get("timeframe_name").(string))
 
@@ -134,11 +134,11 @@ func resourceTimeframeRead(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceTimeframeVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	cfg, err := readTimeframeOptions(ctx, d.Id(), m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if cfg.TimeframeName == "" {
 		d.SetId("")
@@ -155,10 +155,10 @@ func resourceTimeframeUpdate(
 	d.Partial(true)
 	c := m.(*Client)
 	if err := resourceTimeframeVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := updateTimeframe(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.Partial(false)
 
@@ -170,10 +170,10 @@ func resourceTimeframeDelete(
 ) diag.Diagnostics {
 	c := m.(*Client)
 	if err := resourceTimeframeVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := deleteTimeframe(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -218,11 +218,13 @@ func checkResourceTimeframeExits(
 	if err != nil {
 		return false, err
 	}
-	if code == http.StatusNotFound {
-		return false, nil
-	}
 	if code != http.StatusOK {
-		return false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return false, nil
+		}
+
+		return false, apiErr
 	}
 
 	return true, nil
@@ -241,7 +243,7 @@ func addTimeframe(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -260,7 +262,7 @@ func updateTimeframe(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -275,7 +277,7 @@ func deleteTimeframe(
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
@@ -331,11 +333,13 @@ func readTimeframeOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 	err = json.Unmarshal([]byte(body), &result)
 	if err != nil {
@@ -7,105 +7,203 @@ import (
 	"net/http"
 	"slices"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type jsonUser struct {
-	ForceChangePwd    *bool     `json:"force_change_pwd,omitempty"`
-	IsDisabled        bool      `json:"is_disabled"`
-	UserName          string    `json:"user_name"`
-	CertificateCN     string    `json:"certificate_dn"`
-	DisplayName       string    `json:"display_name"`
-	Email             string    `json:"email"`
-	ExpirationDate    string    `json:"expiration_date"`
-	IPSource          string    `json:"ip_source"`
-	Password          string    `json:"password,omitempty"`
-	PreferredLanguage string    `json:"preferred_language,omitempty"`
-	Profile           string    `json:"profile"`
-	SSHPublicKey      string    `json:"ssh_public_key"`
-	UserAuths         []string  `json:"user_auths"`
-	Groups            *[]string `json:"groups,omitempty"`
+	ForceChangePwd    bool     `json:"force_change_pwd,omitempty"`
+	IsDisabled        bool     `json:"is_disabled"`
+	UserName          string   `json:"user_name"`
+	CertificateCN     string   `json:"certificate_dn"`
+	DisplayName       string   `json:"display_name"`
+	Email             string   `json:"email"`
+	ExpirationDate    string   `json:"expiration_date"`
+	IPSource          string   `json:"ip_source"`
+	Password          string   `json:"password,omitempty"`
+	PreferredLanguage string   `json:"preferred_language,omitempty"`
+	Profile           string   `json:"profile"`
+	SSHPublicKey      string   `json:"ssh_public_key"`
+	UserAuths         []string `json:"user_auths"`
+	Groups            []string `json:"groups"`
 }
 
-func resourceUser() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceUserCreate,
-		ReadContext:   resourceUserRead,
-		UpdateContext: resourceUserUpdate,
-		DeleteContext: resourceUserDelete,
-		Importer: &schema.ResourceImporter{
-			State: resourceUserImport,
-		},
-		Schema: map[string]*schema.Schema{
-			"user_name": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Required: true,
+type resourceUserModel struct {
+	ID                types.String   `tfsdk:"id"`
+	UserName          types.String   `tfsdk:"user_name"`
+	Email             types.String   `tfsdk:"email"`
+	Profile           types.String   `tfsdk:"profile"`
+	UserAuths         types.Set      `tfsdk:"user_auths"`
+	CertificateDN     types.String   `tfsdk:"certificate_dn"`
+	DisplayName       types.String   `tfsdk:"display_name"`
+	ExpirationDate    types.String   `tfsdk:"expiration_date"`
+	ForceChangePwd    types.Bool     `tfsdk:"force_change_pwd"`
+	Groups            types.Set      `tfsdk:"groups"`
+	IPSource          types.String   `tfsdk:"ip_source"`
+	IsDisabled        types.Bool     `tfsdk:"is_disabled"`
+	Password          types.String   `tfsdk:"password"`
+	PasswordWO        types.String   `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64    `tfsdk:"password_wo_version"`
+	PreferredLanguage types.String   `tfsdk:"preferred_language"`
+	SSHPublicKey      types.String   `tfsdk:"ssh_public_key"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// resourceUser is the first resource migrated to terraform-plugin-framework.
+// Its schema keeps the one of terraform-plugin-sdk, with the same version,
+// so the existing states are read without upgrade: the arguments that the
+// sdk saved as empty strings or false when not set default to these values.
+type resourceUser struct {
+	client *Client
+}
+
+func newResourceUser() resource.Resource {
+	return &resourceUser{}
+}
+
+var (
+	_ resource.ResourceWithConfigure   = &resourceUser{}
+	_ resource.ResourceWithImportState = &resourceUser{}
+)
+
+func (r *resourceUser) Metadata(
+	_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *resourceUser) Schema(
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"email": {
-				Type:     schema.TypeString,
+			"user_name": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"profile": {
-				Type:     schema.TypeString,
+			"email": schema.StringAttribute{
 				Required: true,
 			},
-			"user_auths": {
-				Type:     schema.TypeSet,
+			"profile": schema.StringAttribute{
 				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"certificate_dn": {
-				Type:     schema.TypeString,
+			"user_auths": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"certificate_dn": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
 			},
-			"display_name": {
-				Type:     schema.TypeString,
+			"display_name": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
 			},
-			"expiration_date": {
-				Type:     schema.TypeString,
+			"expiration_date": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
 			},
-			"force_change_pwd": {
-				Type:     schema.TypeBool,
+			"force_change_pwd": schema.BoolAttribute{
 				Optional: true,
 			},
-			"groups": {
-				Type:     schema.TypeSet,
+			"groups": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_source": schema.StringAttribute{
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
 				Computed: true,
+				Default:  stringdefault.StaticString(""),
 			},
-			"ip_source": {
-				Type:     schema.TypeString,
+			"is_disabled": schema.BoolAttribute{
 				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
-			"is_disabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
 			},
-			"password": {
-				Type:      schema.TypeString,
+			"password_wo": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+				},
 			},
-			"preferred_language": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"de", "en", "es", "fr", "ru"}, false),
-				Computed:     true,
+			"password_wo_version": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
-			"ssh_public_key": {
-				Type:     schema.TypeString,
+			"preferred_language": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("de", "en", "es", "fr", "ru"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
+			"ssh_public_key": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+func (r *resourceUser) Configure(
+	_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	c, err := frameworkClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("configuring wallix-bastion_user", err.Error())
+
+		return
+	}
+	r.client = c
+}
+
 func resourceUserVersionCheck(version string) error {
 	if slices.Contains(defaultVersionsValid(), version) {
 		return nil
@@ -114,105 +212,243 @@ func resourceUserVersionCheck(version string) error {
 	return fmt.Errorf("resource wallix-bastion_user not available with api version %s", version)
 }
 
-func resourceUserCreate(
-	ctx context.Context, d *schema.ResourceData, m interface{},
-) diag.Diagnostics {
-	c := m.(*Client)
-	if err := resourceUserVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+func (r *resourceUser) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("provider not configured", "the client of the provider is not available")
+
+		return
 	}
-	ex, err := checkResourceUserExists(ctx, d.Get("user_name").(string), m)
+	ctx, recorder := withFailoverRecorder(ctx)
+	defer func() { resp.Diagnostics.Append(recorder.frameworkDiagnostics(r.client)...) }()
+	if err := resourceUserVersionCheck(r.client.bastionAPIVersion); err != nil {
+		resp.Diagnostics.AddError("creating user", err.Error())
+
+		return
+	}
+	var plan, config resourceUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+	ex, err := checkResourceUserExists(ctx, plan.UserName.ValueString(), r.client)
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("creating user", err.Error())
+
+		return
 	}
 	if ex {
-		return diag.FromErr(fmt.Errorf("user_name %s already exists", d.Get("user_name").(string)))
+		resp.Diagnostics.AddError("creating user",
+			fmt.Sprintf("user_name %s already exists", plan.UserName.ValueString()))
+
+		return
 	}
-	err = addUser(ctx, d, m)
-	if err != nil {
-		return diag.FromErr(err)
+	jsonData, diags := prepareUserJSON(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	d.SetId(d.Get("user_name").(string))
+	jsonData.PreferredLanguage = plan.PreferredLanguage.ValueString()
+	jsonData.ForceChangePwd = plan.ForceChangePwd.ValueBool()
+	jsonData.Password = userPassword(plan, config)
+	if err := addUser(ctx, jsonData, r.client); err != nil {
+		resp.Diagnostics.Append(frameworkDiagFromErr("creating user", err)...)
 
-	return resourceUserRead(ctx, d, m)
+		return
+	}
+	plan.ID = plan.UserName
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ID.IsNull() {
+		resp.Diagnostics.AddError("creating user",
+			fmt.Sprintf("user_name %s not found after POST", plan.UserName.ValueString()))
+
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceUserRead(
-	ctx context.Context, d *schema.ResourceData, m interface{},
-) diag.Diagnostics {
-	c := m.(*Client)
-	if err := resourceUserVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+func (r *resourceUser) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("provider not configured", "the client of the provider is not available")
+
+		return
 	}
-	cfg, err := readUserOptions(ctx, d.Get("user_name").(string), m)
-	if err != nil {
-		return diag.FromErr(err)
+	ctx, recorder := withFailoverRecorder(ctx)
+	defer func() { resp.Diagnostics.Append(recorder.frameworkDiagnostics(r.client)...) }()
+	if err := resourceUserVersionCheck(r.client.bastionAPIVersion); err != nil {
+		resp.Diagnostics.AddError("reading user", err.Error())
+
+		return
 	}
-	if cfg.UserName == "" {
-		d.SetId("")
-	} else {
-		fillUser(d, cfg)
+	var state resourceUserModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
 
-	return nil
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceUserUpdate(
-	ctx context.Context, d *schema.ResourceData, m interface{},
-) diag.Diagnostics {
-	d.Partial(true)
-	c := m.(*Client)
-	if err := resourceUserVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
+func (r *resourceUser) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("provider not configured", "the client of the provider is not available")
+
+		return
+	}
+	ctx, recorder := withFailoverRecorder(ctx)
+	defer func() { resp.Diagnostics.Append(recorder.frameworkDiagnostics(r.client)...) }()
+	if err := resourceUserVersionCheck(r.client.bastionAPIVersion); err != nil {
+		resp.Diagnostics.AddError("updating user", err.Error())
+
+		return
+	}
+	var plan, config, state resourceUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if err := updateUser(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	d.Partial(false)
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+	jsonData, diags := prepareUserJSON(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.ForceChangePwd.ValueBool() &&
+		(!plan.Password.Equal(state.Password) || !plan.PasswordWOVersion.Equal(state.PasswordWOVersion)) {
+		jsonData.Password = userPassword(plan, config)
+	}
+	if err := updateUser(ctx, jsonData, r.client); err != nil {
+		resp.Diagnostics.Append(frameworkDiagFromErr("updating user", err)...)
 
-	return resourceUserRead(ctx, d, m)
+		return
+	}
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceUserDelete(
-	ctx context.Context, d *schema.ResourceData, m interface{},
-) diag.Diagnostics {
-	c := m.(*Client)
-	if err := resourceUserVersionCheck(c.bastionAPIVersion); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := deleteUser(ctx, d, m); err != nil {
-		return diag.FromErr(err)
+func (r *resourceUser) Delete(
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
+) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("provider not configured", "the client of the provider is not available")
+
+		return
 	}
+	ctx, recorder := withFailoverRecorder(ctx)
+	defer func() { resp.Diagnostics.Append(recorder.frameworkDiagnostics(r.client)...) }()
+	if err := resourceUserVersionCheck(r.client.bastionAPIVersion); err != nil {
+		resp.Diagnostics.AddError("deleting user", err.Error())
 
-	return nil
+		return
+	}
+	var state resourceUserModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+	if err := deleteUser(ctx, state.UserName.ValueString(), r.client); err != nil {
+		resp.Diagnostics.Append(frameworkDiagFromErr("deleting user", err)...)
+	}
 }
 
-func resourceUserImport(
-	d *schema.ResourceData, m interface{},
-) (
-	[]*schema.ResourceData, error,
+func (r *resourceUser) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
-	ctx := context.Background()
-	c := m.(*Client)
-	if err := resourceUserVersionCheck(c.bastionAPIVersion); err != nil {
-		return nil, err
+	if r.client == nil {
+		resp.Diagnostics.AddError("provider not configured", "the client of the provider is not available")
+
+		return
 	}
-	ex, err := checkResourceUserExists(ctx, d.Id(), m)
+	ctx, recorder := withFailoverRecorder(ctx)
+	defer func() { resp.Diagnostics.Append(recorder.frameworkDiagnostics(r.client)...) }()
+	if err := resourceUserVersionCheck(r.client.bastionAPIVersion); err != nil {
+		resp.Diagnostics.AddError("importing user", err.Error())
+
+		return
+	}
+	ex, err := checkResourceUserExists(ctx, req.ID, r.client)
 	if err != nil {
-		return nil, err
+		resp.Diagnostics.AddError("importing user", err.Error())
+
+		return
 	}
 	if !ex {
-		return nil, fmt.Errorf("don't find user_name with id %s (id must be <user_name>)", d.Id())
+		resp.Diagnostics.AddError("importing user",
+			fmt.Sprintf("don't find user_name with id %s (id must be <user_name>)", req.ID))
+
+		return
 	}
-	cfg, err := readUserOptions(ctx, d.Id(), m)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_name"), req.ID)...)
+}
+
+// read refreshes data with the user of the bastion, or sets its ID to null
+// when the user doesn't exist anymore.
+func (r *resourceUser) read(ctx context.Context, data *resourceUserModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	cfg, err := readUserOptions(ctx, data.UserName.ValueString(), r.client)
 	if err != nil {
-		return nil, err
+		diags.Append(frameworkDiagFromErr("reading user", err)...)
+
+		return diags
 	}
-	fillUser(d, cfg)
-	result := make([]*schema.ResourceData, 1)
-	result[0] = d
+	if cfg.UserName == "" {
+		data.ID = types.StringNull()
 
-	return result, nil
+		return diags
+	}
+	diags.Append(fillUserModel(ctx, data, cfg)...)
+
+	return diags
 }
 
 func checkResourceUserExists(
@@ -225,105 +461,94 @@ func checkResourceUserExists(
 	if err != nil {
 		return false, err
 	}
-	if code == http.StatusNotFound {
-		return false, nil
-	}
 	if code != http.StatusOK {
-		return false, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return false, nil
+		}
+
+		return false, apiErr
 	}
 
 	return true, nil
 }
 
 func addUser(
-	ctx context.Context, d *schema.ResourceData, m interface{},
+	ctx context.Context, jsonData jsonUser, c *Client,
 ) error {
-	c := m.(*Client)
-	jsonData := prepareUserJSON(d, true)
 	body, code, err := c.newRequest(ctx, "/users/", http.MethodPost, jsonData)
 	if err != nil {
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
 }
 
 func updateUser(
-	ctx context.Context, d *schema.ResourceData, m interface{},
+	ctx context.Context, jsonData jsonUser, c *Client,
 ) error {
-	c := m.(*Client)
-	jsonData := prepareUserJSON(d, false)
-	body, code, err := c.newRequest(ctx, "/users/"+d.Get("user_name").(string)+"?force=true", http.MethodPut, jsonData)
+	body, code, err := c.newRequest(ctx, "/users/"+jsonData.UserName+"?force=true", http.MethodPut, jsonData)
 	if err != nil {
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
 }
 
 func deleteUser(
-	ctx context.Context, d *schema.ResourceData, m interface{},
+	ctx context.Context, userName string, c *Client,
 ) error {
-	c := m.(*Client)
-	body, code, err := c.newRequest(ctx, "/users/"+d.Get("user_name").(string), http.MethodDelete, nil)
+	body, code, err := c.newRequest(ctx, "/users/"+userName, http.MethodDelete, nil)
 	if err != nil {
 		return err
 	}
 	if code != http.StatusOK && code != http.StatusNoContent {
-		return fmt.Errorf("api doesn't return OK or NoContent: %d with body:\n%s", code, body)
+		return newAPIError(code, body)
 	}
 
 	return nil
 }
 
-func prepareUserJSON(d *schema.ResourceData, newResource bool) jsonUser {
-	b := true
+// prepareUserJSON returns the user of plan without the arguments only sent
+// at creation or on change (preferred_language, force_change_pwd, password).
+// The groups are always sent, those of the state when they aren't set.
+func prepareUserJSON(ctx context.Context, plan resourceUserModel) (jsonUser, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	jsonData := jsonUser{
-		UserName:       d.Get("user_name").(string),
-		DisplayName:    d.Get("display_name").(string),
-		Email:          d.Get("email").(string),
-		IPSource:       d.Get("ip_source").(string),
-		Profile:        d.Get("profile").(string),
-		SSHPublicKey:   d.Get("ssh_public_key").(string),
-		CertificateCN:  d.Get("certificate_dn").(string),
-		ExpirationDate: d.Get("expiration_date").(string),
-		IsDisabled:     d.Get("is_disabled").(bool),
-	}
-
-	if newResource {
-		jsonData.PreferredLanguage = d.Get("preferred_language").(string)
-		jsonData.Password = d.Get("password").(string)
-		if d.Get("force_change_pwd").(bool) {
-			jsonData.ForceChangePwd = &b
-		}
-	} else if d.HasChange("password") && !d.Get("force_change_pwd").(bool) {
-		if v := d.Get("password").(string); v != "" {
-			jsonData.Password = v
-		}
-	}
-
-	if d.HasChanges("groups") {
-		listGroups := d.Get("groups").(*schema.Set).List()
-		groups := make([]string, len(listGroups))
-		for i, v := range listGroups {
-			groups[i] = v.(string)
-		}
-		jsonData.Groups = &groups
-	}
+		UserName:       plan.UserName.ValueString(),
+		DisplayName:    plan.DisplayName.ValueString(),
+		Email:          plan.Email.ValueString(),
+		IPSource:       plan.IPSource.ValueString(),
+		Profile:        plan.Profile.ValueString(),
+		SSHPublicKey:   plan.SSHPublicKey.ValueString(),
+		CertificateCN:  plan.CertificateDN.ValueString(),
+		ExpirationDate: plan.ExpirationDate.ValueString(),
+		IsDisabled:     plan.IsDisabled.ValueBool(),
+		UserAuths:      make([]string, 0),
+		Groups:         make([]string, 0),
+	}
+	diags.Append(plan.UserAuths.ElementsAs(ctx, &jsonData.UserAuths, false)...)
+	if !plan.Groups.IsUnknown() {
+		diags.Append(plan.Groups.ElementsAs(ctx, &jsonData.Groups, false)...)
+	}
+
+	return jsonData, diags
+}
 
-	listUserAuths := d.Get("user_auths").(*schema.Set).List()
-	jsonData.UserAuths = make([]string, len(listUserAuths))
-	for i, v := range listUserAuths {
-		jsonData.UserAuths[i] = v.(string)
+// userPassword returns the password of plan, or password_wo of config which
+// is never in the plan.
+func userPassword(plan, config resourceUserModel) string {
+	if v := plan.Password.ValueString(); v != "" {
+		return v
 	}
 
-	return jsonData
+	return config.PasswordWO.ValueString()
 }
 
 func readUserOptions(
@@ -337,11 +562,13 @@ func readUserOptions(
 	if err != nil {
 		return result, err
 	}
-	if code == http.StatusNotFound {
-		return result, nil
-	}
 	if code != http.StatusOK {
-		return result, fmt.Errorf("api doesn't return OK: %d with body:\n%s", code, body)
+		apiErr := newAPIError(code, body)
+		if IsNotFound(apiErr) {
+			return result, nil
+		}
+
+		return result, apiErr
 	}
 
 	err = json.Unmarshal([]byte(body), &result)
@@ -352,41 +579,34 @@ func readUserOptions(
 	return result, nil
 }
 
-func fillUser(d *schema.ResourceData, jsonData jsonUser) {
-	if tfErr := d.Set("user_name", jsonData.UserName); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("email", jsonData.Email); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("profile", jsonData.Profile); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("user_auths", jsonData.UserAuths); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("certificate_dn", jsonData.CertificateCN); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("display_name", jsonData.DisplayName); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("expiration_date", jsonData.ExpirationDate); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("groups", jsonData.Groups); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("ip_source", jsonData.IPSource); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("is_disabled", jsonData.IsDisabled); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("preferred_language", jsonData.PreferredLanguage); tfErr != nil {
-		panic(tfErr)
-	}
-	if tfErr := d.Set("ssh_public_key", jsonData.SSHPublicKey); tfErr != nil {
-		panic(tfErr)
+// fillUserModel sets the attributes of data returned by the bastion; the
+// password and the arguments only used at creation keep their value.
+func fillUserModel(ctx context.Context, data *resourceUserModel, jsonData jsonUser) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+	data.UserName = types.StringValue(jsonData.UserName)
+	data.Email = types.StringValue(jsonData.Email)
+	data.Profile = types.StringValue(jsonData.Profile)
+	data.UserAuths, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(jsonData.UserAuths))
+	diags.Append(d...)
+	data.CertificateDN = types.StringValue(jsonData.CertificateCN)
+	data.DisplayName = types.StringValue(jsonData.DisplayName)
+	data.ExpirationDate = types.StringValue(jsonData.ExpirationDate)
+	data.Groups, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(jsonData.Groups))
+	diags.Append(d...)
+	data.IPSource = types.StringValue(jsonData.IPSource)
+	data.IsDisabled = types.BoolValue(jsonData.IsDisabled)
+	data.PreferredLanguage = types.StringValue(jsonData.PreferredLanguage)
+	data.SSHPublicKey = types.StringValue(jsonData.SSHPublicKey)
+
+	return diags
+}
+
+// nonNilStrings returns list, or an empty list when it is nil, as a nil
+// list would be a null set and the sdk saved empty sets.
+func nonNilStrings(list []string) []string {
+	if list == nil {
+		return []string{}
 	}
+
+	return list
 }
@@ -1,6 +1,7 @@
 package bastion_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -8,8 +9,8 @@ import (
 
 func TestAccResourceUser_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
 		ExternalProviders: map[string]resource.ExternalProvider{
 			"random": {
 				Source: "hashicorp/random",
@@ -95,6 +96,120 @@ resource "wallix-bastion_user" "testacc_User" {
   ip_source       = "127.0.0.1"
   is_disabled     = true
   ssh_public_key  = tls_private_key.testacc_User.public_key_openssh
+
+  timeouts {
+    update = "5m"
+    read   = "1m"
+  }
+}
+`
+}
+
+func TestAccResourceUser_writeOnly(t *testing.T) {
+	resourceName := "wallix-bastion_user.testacc_UserWriteOnly"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckTerraformVersion(t, "v1.11.0")
+		},
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserWriteOnly("aPassword_1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "password"),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+					testAccCheckFakeBastionField("/users/", "testacc_UserWriteOnly", "password", "aPassword_1"),
+				),
+			},
+			{
+				// without a new version, the password isn't sent
+				Config: testAccResourceUserWriteOnly("aPassword_2", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFakeBastionField("/users/", "testacc_UserWriteOnly", "password", "aPassword_1"),
+				),
+			},
+			{
+				Config: testAccResourceUserWriteOnly("aPassword_2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "2"),
+					testAccCheckFakeBastionField("/users/", "testacc_UserWriteOnly", "password", "aPassword_2"),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccResourceUserWriteOnly(password string, version int) string {
+	return fmt.Sprintf(`
+resource "wallix-bastion_user" "testacc_UserWriteOnly" {
+  user_name           = "testacc_UserWriteOnly"
+  email               = "testacc-userwriteonly@none.none"
+  profile             = "user"
+  user_auths          = ["local_password"]
+  password_wo         = %q
+  password_wo_version = %d
+}
+`, password, version)
+}
+
+func TestAccResourceUser_upgradeFromSDK(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheckSDKProvider(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: testAccSDKProviders,
+				Config:            testAccResourceUserUpgradeFromSDK(),
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				Config:                   testAccResourceUserUpgradeFromSDK(),
+				PlanOnly:                 true,
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				Config:                   testAccResourceUserUpgradeFromSDK(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_user.testacc_UserUpgrade", "groups.#", "1"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_user.testacc_UserUpgrade", "force_change_pwd", "true"),
+					resource.TestCheckResourceAttr(
+						"wallix-bastion_user.testacc_UserUpgrade2", "display_name", ""),
+				),
+			},
+		},
+		PreventPostDestroyRefresh: true,
+	})
+}
+
+func testAccResourceUserUpgradeFromSDK() string {
+	return `
+resource "wallix-bastion_usergroup" "testacc_UserUpgrade" {
+  group_name = "testacc_UserUpgrade"
+  timeframes = ["allthetime"]
+}
+resource "wallix-bastion_user" "testacc_UserUpgrade" {
+  user_name          = "testacc_UserUpgrade"
+  email              = "testacc-userupgrade@none.none"
+  profile            = "user"
+  user_auths         = ["local_password", "local_sshkey"]
+  groups             = [wallix-bastion_usergroup.testacc_UserUpgrade.group_name]
+  display_name       = "testacc UserUpgrade"
+  expiration_date    = "2032-01-03 00:01"
+  ip_source          = "127.0.0.1"
+  force_change_pwd   = true
+  preferred_language = "fr"
+  password           = "aPassword_1"
+}
+resource "wallix-bastion_user" "testacc_UserUpgrade2" {
+  user_name  = "testacc_UserUpgrade2"
+  email      = "testacc-userupgrade2@none.none"
+  profile    = "user"
+  user_auths = ["local_password"]
 }
 `
 }
@@ -0,0 +1,221 @@
+package bastion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const searchPageLimit = 100
+
+// listResources pages through the collection at path with limit/offset and
+// returns every object that the bastion matches with query.
+func listResources(
+	ctx context.Context, path string, query url.Values, m interface{},
+) (
+	[]json.RawMessage, error,
+) {
+	c := m.(*Client)
+	results := make([]json.RawMessage, 0)
+	pageQuery := url.Values{}
+	for k, v := range query {
+		pageQuery[k] = v
+	}
+	pageQuery.Set("limit", strconv.Itoa(searchPageLimit))
+	for offset := 0; ; offset += searchPageLimit {
+		pageQuery.Set("offset", strconv.Itoa(offset))
+		body, code, err := c.newRequest(ctx, path+"?"+pageQuery.Encode(), http.MethodGet, nil)
+		if err != nil {
+			return nil, err
+		}
+		if code != http.StatusOK {
+			return nil, newAPIError(code, body)
+		}
+		var page []json.RawMessage
+		err = json.Unmarshal([]byte(body), &page)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshaling json: %w", err)
+		}
+		results = append(results, page...)
+		if len(page) < searchPageLimit {
+			return results, nil
+		}
+	}
+}
+
+// searchOperators are the characters of the bastion search syntax, compared
+// literally in a value when escaped with a backslash.
+const searchOperators = `\*&|=!()`
+
+// searchEscape escapes the search operators in value, so that a name with
+// them matches itself instead of changing the query.
+func searchEscape(value string) string {
+	var escaped strings.Builder
+	for _, r := range value {
+		if strings.ContainsRune(searchOperators, r) {
+			escaped.WriteByte('\\')
+		}
+		escaped.WriteRune(r)
+	}
+
+	return escaped.String()
+}
+
+// searchQuery returns the q parameter of the bastion API which matches the
+// objects with all the field=value filters, in a stable order.
+func searchQuery(filters map[string]string) string {
+	criteria := make([]string, 0, len(filters))
+	for k, v := range filters {
+		criteria = append(criteria, k+"="+searchEscape(v))
+	}
+	slices.Sort(criteria)
+
+	return strings.Join(criteria, "&&")
+}
+
+// searchResources returns the objects of the collection at path with field
+// equal to value, ignoring case like the bastion.
+func searchResources(
+	ctx context.Context, path, field, value string, m interface{},
+) (
+	[]json.RawMessage, error,
+) {
+	filters := map[string]string{field: value}
+	candidates, err := listResources(ctx, path, url.Values{"q": []string{searchQuery(filters)}}, m)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterResources(candidates, filters)
+}
+
+// filterResources keeps the objects with each field of filters equal to its
+// value, or containing it for a list field, ignoring case like the bastion.
+// The bastion query can match more (wildcards), so the results of a search
+// are filtered again client-side.
+func filterResources(objects []json.RawMessage, filters map[string]string) ([]json.RawMessage, error) {
+	results := make([]json.RawMessage, 0, len(objects))
+	for _, v := range objects {
+		var fields map[string]interface{}
+		if err := json.Unmarshal(v, &fields); err != nil {
+			return nil, fmt.Errorf("unmarshaling json: %w", err)
+		}
+		if matchFilters(fields, filters) {
+			results = append(results, v)
+		}
+	}
+
+	return results, nil
+}
+
+func matchFilters(fields map[string]interface{}, filters map[string]string) bool {
+	for k, v := range filters {
+		switch field := fields[k].(type) {
+		case nil:
+			return false
+		case []interface{}:
+			if !slices.ContainsFunc(field, func(e interface{}) bool { return strings.EqualFold(fmt.Sprint(e), v) }) {
+				return false
+			}
+		default:
+			if !strings.EqualFold(fmt.Sprint(field), v) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// searchResourceID returns the id of the object of the collection at path
+// with field equal to value, ignoring case like the bastion, and an error if
+// several objects match. Among objects differing only in case, the one with
+// the case of value is chosen.
+func searchResourceID(
+	ctx context.Context, path, field, value string, m interface{},
+) (
+	string, bool, error,
+) {
+	results, err := searchResources(ctx, path, field, value, m)
+	if err != nil {
+		return "", false, err
+	}
+	if len(results) > 1 {
+		sameCase := slices.DeleteFunc(slices.Clone(results), func(v json.RawMessage) bool {
+			var fields map[string]interface{}
+
+			return json.Unmarshal(v, &fields) != nil || fields[field] != value
+		})
+		if len(sameCase) == 1 {
+			results = sameCase
+		}
+	}
+	switch len(results) {
+	case 0:
+		return "", false, nil
+	case 1:
+		var result struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(results[0], &result); err != nil {
+			return "", false, fmt.Errorf("unmarshaling json: %w", err)
+		}
+
+		return result.ID, true, nil
+	default:
+		return "", false, fmt.Errorf("%d objects found in %s with %s %q, can't choose one", len(results), path, field, value)
+	}
+}
+
+// listResourcesAs returns the objects of listResources unmarshaled as T.
+func listResourcesAs[T any](
+	ctx context.Context, path string, query url.Values, m interface{},
+) (
+	[]T, error,
+) {
+	list, err := listResources(ctx, path, query, m)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalResources[T](list)
+}
+
+// searchResourcesAs returns the objects of the collection at path matching
+// exactly all the filters, unmarshaled as T. The q parameter of query is set
+// with the filters.
+func searchResourcesAs[T any](
+	ctx context.Context, path string, filters map[string]string, query url.Values, m interface{},
+) (
+	[]T, error,
+) {
+	if len(filters) > 0 {
+		query.Set("q", searchQuery(filters))
+	}
+	list, err := listResources(ctx, path, query, m)
+	if err != nil {
+		return nil, err
+	}
+	list, err = filterResources(list, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalResources[T](list)
+}
+
+func unmarshalResources[T any](list []json.RawMessage) ([]T, error) {
+	result := make([]T, len(list))
+	for i, v := range list {
+		if err := json.Unmarshal(v, &result[i]); err != nil {
+			return nil, fmt.Errorf("unmarshaling json: %w", err)
+		}
+	}
+
+	return result, nil
+}
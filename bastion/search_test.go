@@ -0,0 +1,196 @@
+package bastion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newTestSearchServer serves devices with a case-insensitive prefix match on
+// the device_name criteria of the q parameter, like a bastion query with a
+// trailing wildcard, and limit/offset pagination.
+func newTestSearchServer(t *testing.T, devices []jsonDevice) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/"+VersionWallixAPI38+"/devices/" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		query := r.URL.Query()
+		criteria := splitTestSearchQuery(query.Get("q"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		matches := make([]jsonDevice, 0)
+		for _, v := range devices {
+			if !slices.ContainsFunc(criteria, func(c [2]string) bool {
+				return c[0] != "device_name" || !strings.HasPrefix(strings.ToLower(v.DeviceName), strings.ToLower(c[1]))
+			}) {
+				matches = append(matches, v)
+			}
+		}
+		matches = matches[min(offset, len(matches)):min(offset+limit, len(matches))]
+		if err := json.NewEncoder(w).Encode(matches); err != nil {
+			t.Error(err)
+		}
+	}))
+}
+
+// splitTestSearchQuery splits query in field and unescaped value pairs on
+// the operators && and = which aren't escaped with a backslash, like the q
+// parameter of the bastion. A criterion without = has an empty value.
+// It's shared with the fake bastion of the acceptance tests.
+func splitTestSearchQuery(query string) [][2]string {
+	var criteria [][2]string
+	var field, current strings.Builder
+	inValue := false
+	flush := func() {
+		if inValue {
+			criteria = append(criteria, [2]string{field.String(), current.String()})
+		} else {
+			criteria = append(criteria, [2]string{current.String(), ""})
+		}
+		field.Reset()
+		current.Reset()
+		inValue = false
+	}
+	for i := 0; i < len(query); i++ {
+		switch {
+		case query[i] == '\\' && i+1 < len(query):
+			i++
+			current.WriteByte(query[i])
+		case strings.HasPrefix(query[i:], "&&"):
+			flush()
+			i++
+		case query[i] == '=' && !inValue:
+			field.WriteString(current.String())
+			current.Reset()
+			inValue = true
+		default:
+			current.WriteByte(query[i])
+		}
+	}
+	flush()
+
+	return criteria
+}
+
+func TestSearchResourceID(t *testing.T) {
+	devices := []jsonDevice{
+		{ID: "1", DeviceName: "web"},
+		{ID: "2", DeviceName: "web 2"},
+		{ID: "3", DeviceName: "a&b+c d"},
+		{ID: "4", DeviceName: "dup"},
+		{ID: "6", DeviceName: "ops&&device_name=web"},
+		{ID: "7", DeviceName: `s*=(1|!2)\`},
+		{ID: "5", DeviceName: "dup"},
+		{ID: "8", DeviceName: "Mixed"},
+		{ID: "9", DeviceName: "mixed"},
+	}
+	for i := range 2 * searchPageLimit {
+		devices = append(devices, jsonDevice{ID: "page" + strconv.Itoa(i), DeviceName: "page" + strconv.Itoa(i)})
+	}
+	devices = append(devices, jsonDevice{ID: "last", DeviceName: "page"})
+	server := newTestSearchServer(t, devices)
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	tests := []struct {
+		name    string
+		wantID  string
+		wantEx  bool
+		wantErr bool
+	}{
+		{name: "web", wantID: "1", wantEx: true},
+		{name: "WEB", wantID: "1", wantEx: true},
+		{name: "mixed", wantID: "9", wantEx: true},
+		{name: "Mixed", wantID: "8", wantEx: true},
+		{name: "MIXED", wantErr: true},
+		{name: "web 2", wantID: "2", wantEx: true},
+		{name: "a&b+c d", wantID: "3", wantEx: true},
+		{name: "ops&&device_name=web", wantID: "6", wantEx: true},
+		{name: `s*=(1|!2)\`, wantID: "7", wantEx: true},
+		{name: "page", wantID: "last", wantEx: true},
+		{name: "we", wantEx: false},
+		{name: "dup", wantErr: true},
+	}
+	for _, tt := range tests {
+		id, ex, err := searchResourceDevice(context.Background(), tt.name, client)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("searchResourceDevice(%q): expected an error", tt.name)
+			}
+
+			continue
+		}
+		if err != nil {
+			t.Errorf("searchResourceDevice(%q): unexpected error: %s", tt.name, err)
+
+			continue
+		}
+		if id != tt.wantID || ex != tt.wantEx {
+			t.Errorf("searchResourceDevice(%q) = %q, %t; want %q, %t", tt.name, id, ex, tt.wantID, tt.wantEx)
+		}
+	}
+}
+
+func TestSearchQuery(t *testing.T) {
+	got := searchQuery(map[string]string{"profile": "user", "groups": "admins", "is_disabled": "false"})
+	if want := "groups=admins&&is_disabled=false&&profile=user"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	got = searchQuery(map[string]string{"device_name": `a&&b=c*(d|!e)\`})
+	if want := `device_name=a\&\&b\=c\*\(d\|\!e\)\\`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := searchQuery(nil); got != "" {
+		t.Errorf("got %q for no filter, want empty", got)
+	}
+}
+
+func TestFilterResources(t *testing.T) {
+	objects := []json.RawMessage{
+		json.RawMessage(`{"user_name":"a","profile":"user","groups":["admins","ops"],"is_disabled":false}`),
+		json.RawMessage(`{"user_name":"b","profile":"User","groups":["admins"],"is_disabled":true}`),
+		json.RawMessage(`{"user_name":"c","profile":"user","groups":["admins2"],"is_disabled":false}`),
+		json.RawMessage(`{"user_name":"d","groups":[]}`),
+	}
+	tests := []struct {
+		filters map[string]string
+		want    []string
+	}{
+		{filters: nil, want: []string{"a", "b", "c", "d"}},
+		{filters: map[string]string{"profile": "user"}, want: []string{"a", "b", "c"}},
+		{filters: map[string]string{"groups": "ADMINS"}, want: []string{"a", "b"}},
+		{filters: map[string]string{"groups": "admins"}, want: []string{"a", "b"}},
+		{filters: map[string]string{"is_disabled": "false"}, want: []string{"a", "c"}},
+		{filters: map[string]string{"profile": "user", "groups": "ops"}, want: []string{"a"}},
+		{filters: map[string]string{"profile": "admin"}, want: []string{}},
+	}
+	for _, tt := range tests {
+		results, err := filterResources(objects, tt.filters)
+		if err != nil {
+			t.Fatalf("filterResources(%v): unexpected error: %s", tt.filters, err)
+		}
+		got := make([]string, len(results))
+		for i, v := range results {
+			var user jsonUser
+			if err := json.Unmarshal(v, &user); err != nil {
+				t.Fatal(err)
+			}
+			got[i] = user.UserName
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("filterResources(%v) = %v, want %v", tt.filters, got, tt.want)
+		}
+	}
+	if _, err := filterResources([]json.RawMessage{json.RawMessage(`[]`)}, nil); err == nil {
+		t.Error("filterResources on a non-object: expected an error")
+	}
+}
@@ -0,0 +1,144 @@
+package bastion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	AuthModeBasic   = "basic"
+	AuthModeSession = "session"
+
+	sessionLogoutTimeout = 5 * time.Second
+)
+
+func defaultAuthModesValid() []string {
+	return []string{
+		AuthModeBasic,
+		AuthModeSession,
+	}
+}
+
+// sessionClients tracks the clients with a bastion session opened,
+// to log them out when the provider stops.
+var sessionClients struct { //nolint:gochecknoglobals
+	sync.Mutex
+	clients []*Client
+}
+
+// session keeps the cookies set by the bastion after a successful
+// authentication so that next requests don't authenticate again.
+type session struct {
+	mutex sync.Mutex
+	jar   *cookiejar.Jar
+}
+
+func newSession() *session {
+	jar, _ := cookiejar.New(nil)
+
+	return &session{jar: jar}
+}
+
+func (s *session) cookies(u *url.URL) []*http.Cookie {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.jar.Cookies(u)
+}
+
+func (s *session) setCookies(u *url.URL, cookies []*http.Cookie) {
+	if len(cookies) == 0 {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.jar.SetCookies(u, cookies)
+}
+
+func (s *session) reset() {
+	jar, _ := cookiejar.New(nil)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.jar = jar
+}
+
+func registerSessionClient(c *Client) {
+	sessionClients.Lock()
+	defer sessionClients.Unlock()
+	sessionClients.clients = append(sessionClients.clients, c)
+}
+
+// CloseSessions logs out the bastion sessions opened by the provider.
+// It's called when the plugin server stops.
+func CloseSessions() {
+	sessionClients.Lock()
+	defer sessionClients.Unlock()
+	for _, c := range sessionClients.clients {
+		ctx, cancel := context.WithTimeout(context.Background(), sessionLogoutTimeout)
+		_ = c.logout(ctx)
+		cancel()
+	}
+	sessionClients.clients = nil
+}
+
+// logout closes the sessions opened on the nodes of the bastion, as a
+// failover opens a new session on the next node.
+// Cookies are kept per host, so a host is logged out once.
+func (c *Client) logout(ctx context.Context) error {
+	var errs []error
+	loggedOut := make(map[string]bool, len(c.bastionNodes))
+	for _, node := range c.bastionNodes {
+		logoutURL, err := url.Parse(c.nodeURL(node) + "/api/logout")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("preparing logout url: %w", err))
+
+			continue
+		}
+		if loggedOut[logoutURL.Hostname()] {
+			continue
+		}
+		loggedOut[logoutURL.Hostname()] = true
+		if err := c.logoutNode(ctx, logoutURL); err != nil {
+			errs = append(errs, fmt.Errorf("logging out from %s: %w", node, err))
+		}
+	}
+	c.session.reset()
+
+	return errors.Join(errs...)
+}
+
+func (c *Client) logoutNode(ctx context.Context, logoutURL *url.URL) error {
+	cookies := c.session.cookies(logoutURL)
+	if len(cookies) == 0 {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, logoutURL.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("preparing http request: %w", err)
+	}
+	req.Header.Add("User-Agent", "terraform-provider-wallix-bastion")
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending http request: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading http response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newAPIError(resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package bastion
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClientSessionAuth(t *testing.T) {
+	var logins, logouts atomic.Int32
+	var sessionID atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/logout" {
+			logouts.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+		if cookie, err := r.Cookie("session"); err == nil {
+			if r.Header.Get("Authorization") != "" {
+				t.Error("credentials sent with a session cookie")
+			}
+			if cookie.Value != strconv.Itoa(int(sessionID.Load())) {
+				w.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+		if user, pwd, ok := r.BasicAuth(); !ok || user != "admin" || pwd != "admin" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+		logins.Add(1)
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: strconv.Itoa(int(sessionID.Load())), Path: "/"})
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	client.session = newSession()
+	for range 3 {
+		if _, code, err := client.newRequest(context.Background(), "/users/", http.MethodGet, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if code != http.StatusNoContent {
+			t.Fatalf("got status %d, want %d", code, http.StatusNoContent)
+		}
+	}
+	if got := logins.Load(); got != 1 {
+		t.Errorf("got %d logins, want 1", got)
+	}
+
+	// expire the session on the bastion side
+	sessionID.Add(1)
+	if _, code, err := client.newRequest(context.Background(), "/users/", http.MethodGet, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if code != http.StatusNoContent {
+		t.Fatalf("got status %d after session expiration, want %d", code, http.StatusNoContent)
+	}
+	if got := logins.Load(); got != 2 {
+		t.Errorf("got %d logins, want 2", got)
+	}
+
+	if err := client.logout(context.Background()); err != nil {
+		t.Fatalf("unexpected error on logout: %s", err)
+	}
+	if got := logouts.Load(); got != 1 {
+		t.Errorf("got %d logouts, want 1", got)
+	}
+	if err := client.logout(context.Background()); err != nil {
+		t.Fatalf("unexpected error on second logout: %s", err)
+	}
+	if got := logouts.Load(); got != 1 {
+		t.Errorf("got %d logouts after a closed session, want 1", got)
+	}
+}
+
+func TestClientSessionLogoutNodes(t *testing.T) {
+	var primaryDown atomic.Bool
+	newNode := func(name string, down *atomic.Bool, logouts *atomic.Int32) *httptest.Server {
+		return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cookie, err := r.Cookie("session")
+			if r.URL.Path == "/api/logout" {
+				if err != nil || cookie.Value != name {
+					w.WriteHeader(http.StatusUnauthorized)
+
+					return
+				}
+				logouts.Add(1)
+				w.WriteHeader(http.StatusNoContent)
+
+				return
+			}
+			if down != nil && down.Load() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+
+				return
+			}
+			if err != nil {
+				http.SetCookie(w, &http.Cookie{Name: "session", Value: name, Path: "/"})
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	}
+	var primaryLogouts, secondaryLogouts atomic.Int32
+	primary := newNode("primary", &primaryDown, &primaryLogouts)
+	defer primary.Close()
+	secondary := newNode("secondary", nil, &secondaryLogouts)
+	defer secondary.Close()
+
+	// each node has its own host name, as cookies are kept per host
+	hosts := map[string]string{
+		"primary.test":   strings.TrimPrefix(primary.URL, "https://"),
+		"secondary.test": strings.TrimPrefix(secondary.URL, "https://"),
+	}
+	client := newTestClient(t, primary)
+	client.maxRetries = 0
+	client.bastionNodes = []string{"primary.test:443", "secondary.test:443"}
+	client.httpClient = &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint: gosec
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			host, _, _ := net.SplitHostPort(addr)
+
+			return (&net.Dialer{}).DialContext(ctx, network, hosts[host])
+		},
+	}}
+	client.session = newSession()
+
+	for _, down := range []bool{false, true} {
+		primaryDown.Store(down)
+		if _, code, err := client.newRequest(context.Background(), "/users/", http.MethodGet, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if code != http.StatusNoContent {
+			t.Fatalf("got status %d, want %d", code, http.StatusNoContent)
+		}
+	}
+	if index, _ := client.activeNode(); index != 1 {
+		t.Fatalf("active node is %d, want 1", index)
+	}
+
+	if err := client.logout(context.Background()); err != nil {
+		t.Fatalf("unexpected error on logout: %s", err)
+	}
+	if primaryLogouts.Load() != 1 || secondaryLogouts.Load() != 1 {
+		t.Errorf("got %d logouts from primary and %d from secondary, want 1 and 1",
+			primaryLogouts.Load(), secondaryLogouts.Load())
+	}
+}
@@ -0,0 +1,74 @@
+package bastion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultResourceTimeout is the default duration of the create, read, update
+// and delete operations of the resources, the one of terraform-plugin-sdk.
+const defaultResourceTimeout = 20 * time.Minute
+
+type timeoutContextKey struct{}
+
+// withTimeout returns a context cancelled after the timeout of an operation,
+// which newRequest reports when it is reached.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithValue(ctx, timeoutContextKey{}, timeout), timeout)
+}
+
+// timeoutError returns err with the timeout of the operation when the deadline
+// of ctx has been reached during a request.
+func timeoutError(ctx context.Context, method, path string, err error) error {
+	if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	timeout, ok := ctx.Value(timeoutContextKey{}).(time.Duration)
+	if !ok {
+		return fmt.Errorf("%s %s timed out: %w", method, path, err)
+	}
+
+	return fmt.Errorf("%s %s timed out after %s, increase the timeouts of the resource "+
+		"if the bastion is slow to respond: %w", method, path, timeout, err)
+}
+
+// withTimeouts declares the create, read, update and delete timeouts of a
+// resource, which can be set in its timeouts block, and wraps its functions
+// to run them with these timeouts.
+func withTimeouts(r *schema.Resource) *schema.Resource {
+	if r.Timeouts == nil {
+		r.Timeouts = &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultResourceTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
+		}
+		if r.UpdateContext != nil {
+			r.Timeouts.Update = schema.DefaultTimeout(defaultResourceTimeout)
+		}
+	}
+	wrap := func(
+		f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, key string,
+	) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			ctx, cancel := withTimeout(ctx, d.Timeout(key))
+			defer cancel()
+
+			return f(ctx, d, m)
+		}
+	}
+	r.CreateContext = wrap(r.CreateContext, schema.TimeoutCreate)
+	r.ReadContext = wrap(r.ReadContext, schema.TimeoutRead)
+	r.UpdateContext = wrap(r.UpdateContext, schema.TimeoutUpdate)
+	r.DeleteContext = wrap(r.DeleteContext, schema.TimeoutDelete)
+
+	return r
+}
@@ -0,0 +1,27 @@
+package bastion
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// writeOnlyString returns the value of a write-only argument, which is only
+// available in the configuration during create and update, empty when unset.
+func writeOnlyString(d *schema.ResourceData, key string) string {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() || v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+
+	return v.AsString()
+}
+
+// secretString returns the value of the secret argument key, or the one of its
+// write-only variant key_wo when it isn't set.
+func secretString(d *schema.ResourceData, key string) string {
+	if v := d.Get(key).(string); v != "" {
+		return v
+	}
+
+	return writeOnlyString(d, key+"_wo")
+}
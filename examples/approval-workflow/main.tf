@@ -95,4 +95,20 @@ resource "wallix-bastion_authorization" "emergency_access" {
   # Still require comments for audit trail
   has_comment       = true
   mandatory_comment = true
-}
\ No newline at end of file
+}
+# Pending approval requests waiting for an answer of the provider user
+data "wallix-bastion_approvals" "to_answer" {
+  to_answer = true
+}
+
+# Accept a pending request without a human approver (for unattended tests)
+resource "wallix-bastion_approval_response" "auto_approve" {
+  count = var.auto_approve_ticket == null ? 0 : 1
+
+  approval_id = one([
+    for v in data.wallix-bastion_approvals.to_answer.approvals : v.id if v.ticket == var.auto_approve_ticket
+  ])
+  approved = true
+  comment  = "Approved by the end-to-end tests"
+  duration = 3600
+}
@@ -49,4 +49,9 @@ variable "single_connection" {
   description = "Whether to allow only single connection"
   type        = bool
   default     = false
-}
\ No newline at end of file
+}
+variable "auto_approve_ticket" {
+  description = "Ticket of a pending approval request to accept with the provider user (for unattended tests)"
+  type        = string
+  default     = null
+}
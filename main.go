@@ -1,13 +1,22 @@
 package main
 
 import (
+	"context"
+	"log"
+
 	"github.com/wallix/terraform-provider-wallix-bastion/bastion"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: bastion.Provider,
-	})
+	serverFactory, err := bastion.ProviderServerFactory(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = tf5server.Serve("registry.terraform.io/wallix/wallix-bastion", serverFactory)
+	bastion.CloseSessions()
+	if err != nil {
+		log.Fatal(err)
+	}
 }